@@ -0,0 +1,78 @@
+// Package ble implements CRC and data whitening of Bluetooth Low Energy link layer packets.
+//
+// The PDU of every packet is followed by CRC-24 calculated with the CRCInit of the connection,
+// or AdvertisingCRCInit on advertising channels. Both PDU and CRC are then whitened
+// with a sequence depending on the channel index. Bytes are transmitted least significant bit first,
+// so the CRC, as returned by PDUCRC, is stored in little endian byte order.
+package ble
+
+import (
+	"math/bits"
+
+	"github.com/ast-dd/crc"
+)
+
+const (
+	// AdvertisingCRCInit is the CRCInit used by packets on advertising channels.
+	AdvertisingCRCInit = 0x555555
+	// AdvertisingAccessAddress is the access address of packets on advertising channels.
+	AdvertisingAccessAddress = 0x8E89BED6
+	// CRCSize is the size of CRC following the PDU.
+	CRCSize = 3
+)
+
+var table = crc.NewTable(crc.CRC24BLE)
+
+// PDUCRC calculates CRC of pdu using crcInit as the initial value of CRC-24/BLE.
+func PDUCRC(crcInit uint32, pdu []byte) uint32 {
+	curValue := table.InitCrcWith(uint64(crcInit))
+	return uint32(table.CRC(table.UpdateCrc(curValue, pdu)))
+}
+
+// AppendCRC appends CRC of pdu to dst in transmission order and returns the extended slice.
+func AppendCRC(dst []byte, crcInit uint32, pdu []byte) []byte {
+	v := PDUCRC(crcInit, pdu)
+	return append(dst, byte(v), byte(v>>8), byte(v>>16))
+}
+
+// CheckCRC reports whether packet, PDU followed by CRC in transmission order, has valid CRC.
+func CheckCRC(crcInit uint32, packet []byte) bool {
+	if len(packet) < CRCSize {
+		return false
+	}
+	pdu, received := packet[:len(packet)-CRCSize], packet[len(packet)-CRCSize:]
+	return PDUCRC(crcInit, pdu) == uint32(received[0])|uint32(received[1])<<8|uint32(received[2])<<16
+}
+
+// Whiten whitens data in place with the sequence of the channel with given index 0-39.
+// The sequence is generated by 7 bit LFSR with polynomial x^7 + x^4 + 1 initialized
+// by the channel index and is applied to bytes least significant bit first.
+// Only the lowest 6 bits of the channel index are used.
+func Whiten(channel uint8, data []byte) {
+	// bit i of lfsr is position i of the specification, position 0 is set to one
+	// and positions 1-6 hold the channel index, the most significant bit in position 1
+	lfsr := byte(1) | bits.Reverse8(channel&0x3F)>>1
+	for i := range data {
+		for bit := byte(1); bit != 0; bit <<= 1 {
+			out := lfsr >> 6 & 1
+			if out != 0 {
+				data[i] ^= bit
+			}
+			lfsr = (lfsr<<1 | out) & 0x7F
+			lfsr ^= out << 4
+		}
+	}
+}
+
+// Dewhiten reverts Whiten, whitening being its own inverse.
+func Dewhiten(channel uint8, data []byte) {
+	Whiten(channel, data)
+}
+
+// CheckAirPacket reports whether packet, PDU followed by CRC as received on the channel
+// with given index before dewhitening, has valid CRC. The packet is not modified.
+func CheckAirPacket(crcInit uint32, channel uint8, packet []byte) bool {
+	dewhitened := append([]byte(nil), packet...)
+	Dewhiten(channel, dewhitened)
+	return CheckCRC(crcInit, dewhitened)
+}
@@ -0,0 +1,127 @@
+package ble_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ast-dd/crc/ble"
+)
+
+// crcBitwise is the shift register of the specification: bits of crcInit are loaded so that
+// its least significant bit is in position 0 and CRC is shifted out from position 23
+func crcBitwise(crcInit uint32, pdu []byte) uint32 {
+	var reg [24]byte
+	for i := range reg {
+		reg[i] = byte(crcInit>>uint(i)) & 1
+	}
+	for _, b := range pdu {
+		for i := 0; i < 8; i++ {
+			in := reg[23] ^ (b>>uint(i))&1
+			for j := 23; j > 0; j-- {
+				reg[j] = reg[j-1]
+				// taps of x^24 + x^10 + x^9 + x^6 + x^4 + x^3 + x + 1
+				switch j {
+				case 10, 9, 6, 4, 3, 1:
+					reg[j] ^= in
+				}
+			}
+			reg[0] = in
+		}
+	}
+	// position 23 is transmitted first, as the least significant bit
+	var v uint32
+	for i := range reg {
+		v |= uint32(reg[23-i]) << uint(i)
+	}
+	return v
+}
+
+// whitenBytewise is the whitening as commonly implemented in software, on bit reversed channel index
+func whitenBytewise(channel uint8, data []byte) {
+	var lfsr byte
+	for i := 0; i < 8; i++ {
+		lfsr |= (channel >> uint(i) & 1) << uint(7-i)
+	}
+	lfsr |= 2
+	for i := range data {
+		for bit := 1; bit < 0x100; bit <<= 1 {
+			if lfsr&0x80 != 0 {
+				lfsr ^= 0x11
+				data[i] ^= byte(bit)
+			}
+			lfsr <<= 1
+		}
+	}
+}
+
+func TestPDUCRC(t *testing.T) {
+	if got := ble.PDUCRC(ble.AdvertisingCRCInit, []byte("123456789")); got != 0xC25A56 {
+		t.Errorf("PDUCRC(123456789) = 0x%06X, want 0xC25A56", got)
+	}
+
+	pdu := []byte{0x40, 0x06, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x1F, 0x00}
+	for _, crcInit := range []uint32{ble.AdvertisingCRCInit, 0x000000, 0xFFFFFF, 0x123456, 0xABCDEF} {
+		for n := 0; n <= len(pdu); n++ {
+			want := crcBitwise(crcInit, pdu[:n])
+			if got := ble.PDUCRC(crcInit, pdu[:n]); got != want {
+				t.Errorf("PDUCRC(0x%06X, % X) = 0x%06X, want 0x%06X", crcInit, pdu[:n], got, want)
+			}
+
+			packet := ble.AppendCRC(append([]byte{}, pdu[:n]...), crcInit, pdu[:n])
+			if len(packet) != n+ble.CRCSize {
+				t.Fatalf("AppendCRC() length = %d, want %d", len(packet), n+ble.CRCSize)
+			}
+			if !ble.CheckCRC(crcInit, packet) {
+				t.Errorf("CheckCRC(0x%06X, % X) = false", crcInit, packet)
+			}
+			packet[len(packet)/2] ^= 0x10
+			if ble.CheckCRC(crcInit, packet) {
+				t.Errorf("CheckCRC(0x%06X, % X) of corrupted packet = true", crcInit, packet)
+			}
+		}
+	}
+	if ble.CheckCRC(ble.AdvertisingCRCInit, []byte{0, 0}) {
+		t.Errorf("CheckCRC() of short packet = true")
+	}
+}
+
+func TestWhiten(t *testing.T) {
+	data := make([]byte, 40)
+	for i := range data {
+		data[i] = byte(i * 37)
+	}
+	for channel := uint8(0); channel < 40; channel++ {
+		got := append([]byte{}, data...)
+		ble.Whiten(channel, got)
+		want := append([]byte{}, data...)
+		whitenBytewise(channel, want)
+		if !bytes.Equal(got, want) {
+			t.Errorf("Whiten(%d) = % X, want % X", channel, got, want)
+		}
+
+		ble.Dewhiten(channel, got)
+		if !bytes.Equal(got, data) {
+			t.Errorf("Dewhiten(%d, Whiten()) = % X, want % X", channel, got, data)
+		}
+	}
+}
+
+func TestCheckAirPacket(t *testing.T) {
+	pdu := []byte{0x02, 0x0A, 0xB0, 0xB1, 0xB2, 0xB3, 0xB4, 0xB5, 0x02, 0x01, 0x06, 0x00}
+	packet := ble.AppendCRC(append([]byte{}, pdu...), 0x5A3C96, pdu)
+	ble.Whiten(37, packet)
+	air := append([]byte{}, packet...)
+
+	if !ble.CheckAirPacket(0x5A3C96, 37, air) {
+		t.Errorf("CheckAirPacket() = false")
+	}
+	if !bytes.Equal(air, packet) {
+		t.Errorf("CheckAirPacket() modified the packet")
+	}
+	if ble.CheckAirPacket(0x5A3C96, 38, air) {
+		t.Errorf("CheckAirPacket() on wrong channel = true")
+	}
+	if ble.CheckAirPacket(ble.AdvertisingCRCInit, 37, air) {
+		t.Errorf("CheckAirPacket() with wrong CRCInit = true")
+	}
+}
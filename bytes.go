@@ -1,47 +1,137 @@
 package crc
 
-import (
-	"encoding/binary"
-)
+import "fmt"
 
 // CalculateCRCBytes works according to CalculateCRC, but returns a byte slice
+// of the smallest length enough to store the CRC, like Table.Size
 func CalculateCRCBytes(crcParams *Parameters, data []byte) []byte {
 	checksum := CalculateCRC(crcParams, data)
-	return checksumToBytes(checksum, int(crcParams.Width/8))
+	return checksumToBytes(checksum, checksumSize(crcParams.Width))
 }
 
 // CalculateCRCBytes works according to CalculateCRC, but returns a byte slice
+// of the smallest length enough to store the CRC, like Table.Size
 func (h *Hash) CalculateCRCBytes(data []byte) []byte {
 	checksum := h.CalculateCRC(data)
-	return checksumToBytes(checksum, int(h.table.crcParams.Width/8))
+	return checksumToBytes(checksum, checksumSize(h.table.crcParams.Width))
+}
+
+// checksumSize returns the number of bytes needed to store CRC of given width
+func checksumSize(width uint) int {
+	return int(width+7) / 8
 }
 
 func checksumToBytes(checksum uint64, l int) []byte {
-	bs := make([]byte, 8)
-	binary.LittleEndian.PutUint64(bs, checksum)
-	return bs[:l]
+	bs := make([]byte, l)
+	putChecksum(bs, checksum, LittleEndian)
+	return bs
 }
 
 func bytesToChecksum(bytes []byte) uint64 {
-	full := make([]byte, 8)
-	copy(full, bytes)
-	return binary.LittleEndian.Uint64(full)
+	return getChecksum(bytes, LittleEndian)
 }
 
 // AppendCRCBytes returns a copy of the data byte slice with the checksum appended
 func AppendCRCBytes(crcParams *Parameters, data []byte) []byte {
-	checksum := CalculateCRCBytes(crcParams, data)
-	appended := make([]byte, len(data), len(data)+len(checksum))
+	l := checksumSize(crcParams.Width)
+	appended := make([]byte, len(data)+l)
 	copy(appended, data)
-	appended = append(appended, checksum...)
+	putChecksum(appended[len(data):], CalculateCRC(crcParams, data), LittleEndian)
 	return appended
 }
 
+// CheckCRCBytes reports whether checksum, as returned by CalculateCRCBytes, matches the CRC of data
 func CheckCRCBytes(crcParams *Parameters, data []byte, checksum []byte) bool {
-	if len(checksum) != int(crcParams.Width/8) {
+	if len(checksum) != checksumSize(crcParams.Width) {
 		return false
 	}
 	got := bytesToChecksum(checksum)
 	calculated := CalculateCRC(crcParams, data)
 	return got == calculated
 }
+
+// Size returns the number of bytes needed to store CRC calculated by this Table.
+func (t *Table) Size() int {
+	return checksumSize(t.crcParams.Width)
+}
+
+// AppendCRC appends the CRC of data to dst in little endian byte order and returns the extended slice.
+// It does not allocate if dst has enough capacity.
+func (t *Table) AppendCRC(dst, data []byte) []byte {
+	var buf [8]byte
+	size := t.PutCRC(buf[:], t.CalculateCRC(data))
+	return append(dst, buf[:size]...)
+}
+
+// PutCRC stores crc into dst in little endian byte order and returns the number of bytes written.
+// It panics if dst is shorter than Size().
+func (t *Table) PutCRC(dst []byte, crc uint64) int {
+	size := t.Size()
+	putChecksum(dst[:size], crc, LittleEndian)
+	return size
+}
+
+// CheckCRC reports whether checksum, stored in little endian byte order, matches the CRC of data.
+func (t *Table) CheckCRC(data, checksum []byte) bool {
+	if len(checksum) != t.Size() {
+		return false
+	}
+	return getChecksum(checksum, LittleEndian) == t.CalculateCRC(data)
+}
+
+// ByteOrder specifies how a checksum is serialized into bytes.
+type ByteOrder int
+
+const (
+	// LittleEndian stores the least significant byte of the checksum first.
+	// This is the order used by CalculateCRCBytes and friends.
+	LittleEndian ByteOrder = iota
+	// BigEndian stores the most significant byte of the checksum first.
+	BigEndian
+)
+
+// String returns the name of the byte order
+func (o ByteOrder) String() string {
+	switch o {
+	case LittleEndian:
+		return "LittleEndian"
+	case BigEndian:
+		return "BigEndian"
+	}
+	return fmt.Sprintf("ByteOrder(%d)", int(o))
+}
+
+// putChecksum stores the lowest len(dst) bytes of checksum into dst using given byte order
+func putChecksum(dst []byte, checksum uint64, order ByteOrder) {
+	l := len(dst)
+	for i := 0; i < l; i++ {
+		b := byte(checksum >> (8 * uint(i)))
+		if order == BigEndian {
+			dst[l-1-i] = b
+		} else {
+			dst[i] = b
+		}
+	}
+}
+
+// getChecksum is the reverse of putChecksum
+func getChecksum(src []byte, order ByteOrder) uint64 {
+	var checksum uint64
+	l := len(src)
+	for i := 0; i < l; i++ {
+		b := src[i]
+		if order == BigEndian {
+			b = src[l-1-i]
+		}
+		checksum |= uint64(b) << (8 * uint(i))
+	}
+	return checksum
+}
+
+// checkTrailerLen validates that a trailer of trailerLen bytes can hold CRC specified by crcParams
+func checkTrailerLen(crcParams *Parameters, trailerLen int) error {
+	if trailerLen < int(crcParams.Width+7)/8 || trailerLen > 8 {
+		return fmt.Errorf("invalid trailer length %d for width %d", trailerLen, crcParams.Width)
+	}
+	return nil
+}
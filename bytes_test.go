@@ -105,3 +105,101 @@ func TestCheckCRCBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestTableAppendCRC(t *testing.T) {
+	for _, tt := range byteTests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := crc.NewTable(tt.crcParams)
+			for i, testString := range byteTestStrings {
+				data := []byte(testString)
+				want := tt.wantBytes[i]
+
+				prefix := []byte{0xAA}
+				if got := table.AppendCRC(prefix, data); !reflect.DeepEqual(got, append([]byte{0xAA}, want...)) {
+					t.Errorf("AppendCRC(%q) = %v, want %v", testString, got, want)
+				}
+
+				buf := make([]byte, 8)
+				if n := table.PutCRC(buf, table.CalculateCRC(data)); !reflect.DeepEqual(buf[:n], want) {
+					t.Errorf("PutCRC(%q) = %v, want %v", testString, buf[:n], want)
+				}
+
+				if !table.CheckCRC(data, want) {
+					t.Errorf("CheckCRC(%q, %v) = false, want true", testString, want)
+				}
+				if table.CheckCRC(data[1:], want) || table.CheckCRC(data, buf[:len(want)+1]) {
+					t.Errorf("CheckCRC(%q) accepted wrong checksum", testString)
+				}
+			}
+		})
+	}
+}
+
+func TestChecksumSize(t *testing.T) {
+	data := []byte(byteTestStrings[0])
+	tests := []struct {
+		crcParams *crc.Parameters
+		want      []byte
+	}{
+		{crc.CRC5USB, []byte{0x19}},
+		{crc.CRC7MMC, []byte{0x75}},
+		{crc.CRC15CAN, []byte{0x9E, 0x05}},
+		{crc.CRC24BLE, []byte{0x56, 0x5A, 0xC2}},
+	}
+	for _, tt := range tests {
+		table := crc.NewTable(tt.crcParams)
+		if got := table.Size(); got != len(tt.want) {
+			t.Errorf("%v Size() = %d, want %d", crc.FormatParameters(tt.crcParams), got, len(tt.want))
+		}
+		if got := crc.CalculateCRCBytes(tt.crcParams, data); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v CalculateCRCBytes() = %v, want %v", crc.FormatParameters(tt.crcParams), got, tt.want)
+		}
+		if got := crc.AppendCRCBytes(tt.crcParams, data); !reflect.DeepEqual(got, table.AppendCRC(data[:len(data):len(data)], data)) {
+			t.Errorf("%v AppendCRCBytes() = %v differs from AppendCRC()", crc.FormatParameters(tt.crcParams), got)
+		}
+		if !crc.CheckCRCBytes(tt.crcParams, data, tt.want) || !table.CheckCRC(data, tt.want) {
+			t.Errorf("%v CheckCRCBytes() or CheckCRC() rejected %v", crc.FormatParameters(tt.crcParams), tt.want)
+		}
+	}
+}
+
+func TestZeroAllocations(t *testing.T) {
+	data := []byte(byteTestStrings[3])
+	table := crc.NewTable(crc.CRC32)
+	checksum := crc.CalculateCRCBytes(crc.CRC32, data)
+	dst := make([]byte, 0, 64)
+	buf := make([]byte, 8)
+
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"Table.AppendCRC", func() { dst = table.AppendCRC(dst[:0], data) }},
+		{"Table.PutCRC", func() { table.PutCRC(buf, table.CalculateCRC(data)) }},
+		{"Table.CheckCRC", func() { table.CheckCRC(data, checksum) }},
+		{"CheckCRCBytes", func() { crc.CheckCRCBytes(crc.CRC32, data, checksum) }},
+	}
+	for _, tt := range tests {
+		if allocs := testing.AllocsPerRun(100, tt.f); allocs != 0 {
+			t.Errorf("%s allocates %v times, want 0", tt.name, allocs)
+		}
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { crc.CalculateCRCBytes(crc.CRC32, data) }); allocs != 1 {
+		t.Errorf("CalculateCRCBytes allocates %v times, want 1", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { crc.AppendCRCBytes(crc.CRC32, data) }); allocs != 1 {
+		t.Errorf("AppendCRCBytes allocates %v times, want 1", allocs)
+	}
+}
+
+func BenchmarkAppendCRC(b *testing.B) {
+	data := []byte(byteTestStrings[3])
+	table := crc.NewTable(crc.CRC16MODBUS)
+	dst := make([]byte, 0, len(data)+2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dst = append(dst[:0], data...)
+		dst = table.AppendCRC(dst, data)
+	}
+}
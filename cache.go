@@ -0,0 +1,134 @@
+package crc
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+)
+
+// defaultCacheCapacity is the capacity of the cache used by CachedTable
+const defaultCacheCapacity = 1024
+
+// CacheStats holds statistics of a TableCache.
+type CacheStats struct {
+	Hits      uint64 // Hits is the number of lookups served from the cache
+	Misses    uint64 // Misses is the number of lookups which had to build a new Table
+	Evictions uint64 // Evictions is the number of tables evicted to keep the cache within its capacity
+	Size      int    // Size is the number of tables currently cached
+}
+
+// TableCache memoizes Table instances by the value of their Parameters.
+// Tables of known CRC algorithms are kept forever, tables of user defined algorithms
+// are evicted in least recently used order once the cache exceeds its capacity.
+// It is safe for concurrent use.
+type TableCache struct {
+	// accessed atomically, kept first for 64-bit alignment on 32-bit platforms
+	hits, misses, evictions uint64
+	knownSize               int64
+
+	known sync.Map // Parameters -> *Table of known algorithms, never evicted
+
+	mu       sync.Mutex
+	capacity int
+	items    map[Parameters]*list.Element
+	lru      list.List // most recently used first
+}
+
+type cacheEntry struct {
+	params Parameters
+	table  *Table
+}
+
+var (
+	tableCache = NewTableCache(defaultCacheCapacity)
+)
+
+// NewTableCache creates a new TableCache holding at most capacity tables of user defined algorithms.
+// Capacity less or equal to zero means the cache is unbounded.
+func NewTableCache(capacity int) *TableCache {
+	return &TableCache{capacity: capacity, items: make(map[Parameters]*list.Element)}
+}
+
+// CachedTable returns a Table for given parameters from a package wide TableCache,
+// creating it if necessary. Parameters are compared by value, not by pointer.
+func CachedTable(crcParams *Parameters) *Table {
+	return tableCache.Get(crcParams)
+}
+
+// CachedTableStats returns statistics of the cache used by CachedTable.
+func CachedTableStats() CacheStats {
+	return tableCache.Stats()
+}
+
+// NewCachedHash creates a new Hash instance using a Table from the cache used by CachedTable.
+func NewCachedHash(crcParams *Parameters) *Hash {
+	return NewHashWithTable(CachedTable(crcParams))
+}
+
+// isKnownParameters reports whether parameters match one of the known CRC algorithms
+func isKnownParameters(crcParams Parameters) bool {
+	_, ok := knownParametersName(crcParams)
+	return ok
+}
+
+// Get returns a Table for given parameters, creating it if necessary.
+func (c *TableCache) Get(crcParams *Parameters) *Table {
+	key := *crcParams
+	if isKnownParameters(key) {
+		if table, ok := c.known.Load(key); ok {
+			atomic.AddUint64(&c.hits, 1)
+			return table.(*Table)
+		}
+		table, loaded := c.known.LoadOrStore(key, NewTable(crcParams))
+		if loaded {
+			atomic.AddUint64(&c.hits, 1)
+		} else {
+			atomic.AddUint64(&c.misses, 1)
+			atomic.AddInt64(&c.knownSize, 1)
+		}
+		return table.(*Table)
+	}
+
+	c.mu.Lock()
+	if elem, ok := c.items[key]; ok {
+		c.lru.MoveToFront(elem)
+		c.mu.Unlock()
+		atomic.AddUint64(&c.hits, 1)
+		return elem.Value.(*cacheEntry).table
+	}
+	c.mu.Unlock()
+
+	// build the table without holding the lock
+	table := NewTable(crcParams)
+	atomic.AddUint64(&c.misses, 1)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.items[key]; ok {
+		// built concurrently by someone else in the meantime
+		c.lru.MoveToFront(elem)
+		return elem.Value.(*cacheEntry).table
+	}
+	c.items[key] = c.lru.PushFront(&cacheEntry{params: key, table: table})
+	for c.capacity > 0 && c.lru.Len() > c.capacity {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).params)
+		atomic.AddUint64(&c.evictions, 1)
+	}
+	return table
+}
+
+// Stats returns statistics of the cache.
+func (c *TableCache) Stats() CacheStats {
+	ret := CacheStats{
+		Hits:      atomic.LoadUint64(&c.hits),
+		Misses:    atomic.LoadUint64(&c.misses),
+		Evictions: atomic.LoadUint64(&c.evictions),
+		Size:      int(atomic.LoadInt64(&c.knownSize)),
+	}
+	c.mu.Lock()
+	ret.Size += c.lru.Len()
+	c.mu.Unlock()
+	return ret
+}
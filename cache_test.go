@@ -0,0 +1,150 @@
+package crc_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ast-dd/crc"
+)
+
+const cacheTestCapacity = 64
+
+func TestTableCache(t *testing.T) {
+	cache := crc.NewTableCache(cacheTestCapacity)
+
+	// known algorithms are looked up by value
+	copied := *crc.CRC16MODBUS
+	table := cache.Get(crc.CRC16MODBUS)
+	if cache.Get(&copied) != table {
+		t.Errorf("Get() returned different tables for equal parameters")
+	}
+	if got, want := table.CalculateCRC([]byte("123456789")), uint64(0x4B37); got != want {
+		t.Errorf("CalculateCRC() = 0x%X, want 0x%X", got, want)
+	}
+	if got, want := cache.Stats(), (crc.CacheStats{Hits: 1, Misses: 1, Size: 1}); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+
+	// user defined algorithms are evicted in LRU order
+	custom := func(i int) *crc.Parameters {
+		return &crc.Parameters{Width: 16, Polynomial: 0x1021, Init: uint64(i)}
+	}
+	for i := 1; i <= 1000; i++ { // Init 0 would be known CRC16XMODEM
+		cache.Get(custom(i))
+	}
+	stats := cache.Stats()
+	if stats.Size > cacheTestCapacity+1 || stats.Evictions != 1000-cacheTestCapacity {
+		t.Errorf("Stats() = %+v, capacity not enforced", stats)
+	}
+	if stats.Misses != 1001 {
+		t.Errorf("Stats().Misses = %d, want 1001", stats.Misses)
+	}
+
+	recent := cache.Get(custom(999))
+	if cache.Get(custom(999)) != recent {
+		t.Errorf("Get() did not return cached table of recently used parameters")
+	}
+	if got, want := recent.CalculateCRC([]byte("123456789")), crc.CalculateCRC(custom(999), []byte("123456789")); got != want {
+		t.Errorf("CalculateCRC() = 0x%X, want 0x%X", got, want)
+	}
+	if cache.Get(crc.CRC16MODBUS) != table {
+		t.Errorf("Get() evicted table of known algorithm")
+	}
+}
+
+func TestTableCacheCapacity(t *testing.T) {
+	for _, capacity := range []int{1, 5, 16, 17, 100} {
+		cache := crc.NewTableCache(capacity)
+		for i := 1; i <= 500; i++ {
+			cache.Get(&crc.Parameters{Width: 32, Polynomial: 0x04C11DB7, Init: uint64(i)})
+			if size := cache.Stats().Size; size > capacity {
+				t.Fatalf("NewTableCache(%d) holds %d tables", capacity, size)
+			}
+		}
+	}
+}
+
+func TestTableCacheWorkingSet(t *testing.T) {
+	// working set smaller than capacity is never evicted
+	cache := crc.NewTableCache(cacheTestCapacity)
+	for round := 0; round < 3; round++ {
+		for i := 1; i <= 20; i++ {
+			cache.Get(&crc.Parameters{Width: 16, Polynomial: 0x8005, Init: uint64(i)})
+		}
+	}
+	if got, want := cache.Stats(), (crc.CacheStats{Hits: 40, Misses: 20, Size: 20}); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+
+	// least recently used table is evicted first
+	cache = crc.NewTableCache(2)
+	a := &crc.Parameters{Width: 16, Polynomial: 0x8005, Init: 1}
+	b := &crc.Parameters{Width: 16, Polynomial: 0x8005, Init: 2}
+	c := &crc.Parameters{Width: 16, Polynomial: 0x8005, Init: 3}
+	tableA := cache.Get(a)
+	cache.Get(b)
+	cache.Get(a)
+	cache.Get(c)
+	if cache.Get(a) != tableA {
+		t.Errorf("Get() evicted recently used table")
+	}
+	if got, want := cache.Stats(), (crc.CacheStats{Hits: 2, Misses: 3, Evictions: 1, Size: 2}); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestTableCacheConcurrent(t *testing.T) {
+	cache := crc.NewTableCache(0)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				params := &crc.Parameters{Width: 32, Polynomial: 0x04C11DB7, Init: uint64(i % 50)}
+				if i%2 == 0 {
+					params = crc.CRC32
+				}
+				h := crc.NewHashWithTable(cache.Get(params))
+				h.Update([]byte("123456789"))
+				if got, want := h.CRC(), crc.CalculateCRC(params, []byte("123456789")); got != want {
+					t.Errorf("CRC() = 0x%X, want 0x%X", got, want)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	stats := cache.Stats()
+	if stats.Size != 26 {
+		t.Errorf("Stats().Size = %d, want 26", stats.Size)
+	}
+	if stats.Hits+stats.Misses != 8*200 {
+		t.Errorf("Stats() = %+v, want %d lookups", stats, 8*200)
+	}
+}
+
+func TestCachedTable(t *testing.T) {
+	before := crc.CachedTableStats()
+	h := crc.NewCachedHash(crc.CRC16X25)
+	h.Update([]byte("123456789"))
+	if got, want := h.CRC16(), uint16(0x906E); got != want {
+		t.Errorf("CRC16() = 0x%X, want 0x%X", got, want)
+	}
+	if crc.CachedTable(crc.X25) != h.Table() {
+		t.Errorf("CachedTable() returned different table for the same parameters")
+	}
+	after := crc.CachedTableStats()
+	if after.Hits+after.Misses != before.Hits+before.Misses+2 {
+		t.Errorf("CachedTableStats() = %+v after %+v, want 2 more lookups", after, before)
+	}
+}
+
+func BenchmarkCachedTable(b *testing.B) {
+	params := &crc.Parameters{Width: 16, Polynomial: 0x8BB6, Init: 0xFFFF}
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			crc.CachedTable(params)
+		}
+	})
+}
@@ -0,0 +1,174 @@
+package can
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Bits is a sequence of bits as transmitted on the bus, each element being 0 (dominant) or 1 (recessive).
+type Bits []byte
+
+// ParseBits parses a string of '0' and '1' characters, ignoring spaces and underscores.
+func ParseBits(s string) (Bits, error) {
+	bits := make(Bits, 0, len(s))
+	for _, c := range s {
+		switch c {
+		case '0', '1':
+			bits = append(bits, byte(c-'0'))
+		case ' ', '_':
+		default:
+			return nil, fmt.Errorf("can: invalid bit %q", c)
+		}
+	}
+	return bits, nil
+}
+
+// String returns the bits as a string of '0' and '1' characters.
+func (b Bits) String() string {
+	var sb strings.Builder
+	sb.Grow(len(b))
+	for _, bit := range b {
+		sb.WriteByte('0' + bit)
+	}
+	return sb.String()
+}
+
+// appendUint appends lowest count bits of v, the most significant first
+func (b Bits) appendUint(v uint64, count int) Bits {
+	for i := count - 1; i >= 0; i-- {
+		b = append(b, byte(v>>uint(i))&1)
+	}
+	return b
+}
+
+// uint returns bits interpreted as unsigned integer, the most significant first
+func (b Bits) uint() uint64 {
+	var v uint64
+	for _, bit := range b {
+		v = v<<1 | uint64(bit&1)
+	}
+	return v
+}
+
+// ErrStuff is returned for bit streams violating the stuffing rule.
+var ErrStuff = errors.New("can: stuff error")
+
+// stuffLimit is the number of equal bits followed by a stuff bit
+const stuffLimit = 5
+
+// Stuff inserts a complementary stuff bit after each run of 5 equal bits and returns the stuffed bits
+// with the number of stuff bits inserted. Stuff bits count to the following runs.
+func Stuff(bits Bits) (Bits, int) {
+	return stuff(bits, true)
+}
+
+// stuff works like Stuff, but if trailing is false, it does not insert a stuff bit after the last bit
+func stuff(bits Bits, trailing bool) (Bits, int) {
+	ret := make(Bits, 0, len(bits)+len(bits)/4)
+	count, run := 0, 0
+	for i, bit := range bits {
+		if len(ret) > 0 && ret[len(ret)-1] == bit {
+			run++
+		} else {
+			run = 1
+		}
+		ret = append(ret, bit)
+		if run == stuffLimit && (trailing || i < len(bits)-1) {
+			ret = append(ret, bit^1)
+			count++
+			run = 1
+		}
+	}
+	return ret, count
+}
+
+// Unstuff removes stuff bits inserted by Stuff and returns the original bits with the number of stuff bits removed.
+// It returns ErrStuff if a stuff bit does not complement the preceding bits.
+func Unstuff(bits Bits) (Bits, int, error) {
+	d := destuffer{bits: bits}
+	ret := make(Bits, 0, len(bits))
+	for d.pos < len(bits) {
+		ret = append(ret, d.next())
+		if d.pos == len(bits)-1 {
+			// trailing stuff bit
+			d.skipStuff()
+		}
+	}
+	if d.err != nil {
+		return nil, 0, d.err
+	}
+	return ret, d.stuffed, nil
+}
+
+// destuffer reads bits of a stuffed bit stream.
+// The first error is kept in err and all following reads return zero bits.
+type destuffer struct {
+	bits    Bits
+	pos     int // position in bits
+	run     int // number of equal bits read, including stuff bits
+	stuffed int // number of stuff bits skipped
+	err     error
+}
+
+// skipStuff skips a stuff bit if one is due after the bits read so far
+func (d *destuffer) skipStuff() {
+	if d.run != stuffLimit {
+		return
+	}
+	last := d.bits[d.pos-1]
+	if bit := d.raw(); d.err == nil && bit == last {
+		d.fail(ErrStuff)
+		return
+	}
+	d.run = 1
+	d.stuffed++
+}
+
+// next returns the next bit skipping stuff bits
+func (d *destuffer) next() byte {
+	d.skipStuff()
+	bit := d.raw()
+	if d.err != nil {
+		return 0
+	}
+	if d.run > 0 && bit == d.bits[d.pos-2] {
+		d.run++
+	} else {
+		d.run = 1
+	}
+	return bit
+}
+
+// uint reads count bits skipping stuff bits, the most significant first
+func (d *destuffer) uint(count int) uint64 {
+	var v uint64
+	for i := 0; i < count; i++ {
+		v = v<<1 | uint64(d.next())
+	}
+	return v
+}
+
+// raw returns the next bit without destuffing
+func (d *destuffer) raw() byte {
+	if d.err != nil {
+		return 0
+	}
+	if d.pos >= len(d.bits) {
+		d.fail(ErrFormat)
+		return 0
+	}
+	bit := d.bits[d.pos]
+	if bit > 1 {
+		d.fail(fmt.Errorf("can: invalid bit value %d", bit))
+		return 0
+	}
+	d.pos++
+	return bit
+}
+
+func (d *destuffer) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
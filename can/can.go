@@ -0,0 +1,331 @@
+// Package can calculates CRC of CAN and CAN FD frames over their bit streams.
+//
+// Classical CAN frames are protected by CRC-15 calculated over the frame bits before stuffing.
+// CAN FD frames, as specified by ISO 11898-1:2015, are protected by CRC-17 (up to 16 data bytes)
+// or CRC-21 calculated over the stuffed bit stream followed by the stuff count field.
+// Both start with the most significant bit of the register set.
+//
+// Bit streams are represented by Bits, frames can be encoded into the bits transmitted
+// on the bus and decoded from them, verifying stuffing and CRC.
+package can
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ast-dd/crc"
+)
+
+var (
+	table15 = crc.NewTable(crc.CRC15CAN)
+	table17 = crc.NewTable(withMSBInit(crc.CRC17CANFD))
+	table21 = crc.NewTable(withMSBInit(crc.CRC21CANFD))
+)
+
+// withMSBInit returns copy of p with initial value having only the most significant bit set
+func withMSBInit(p *crc.Parameters) *crc.Parameters {
+	ret := *p
+	ret.Init = 1 << (p.Width - 1)
+	return &ret
+}
+
+// crcBits calculates CRC over bits using table
+func crcBits(table *crc.Table, bits Bits) uint64 {
+	curValue := table.InitCrc()
+	for len(bits) > 0 {
+		n := len(bits)
+		if n > 64 {
+			n = 64
+		}
+		curValue = table.UpdateBits(curValue, bits[:n].uint(), uint(n))
+		bits = bits[n:]
+	}
+	return table.CRC(curValue)
+}
+
+// CRC15 calculates CRC of a classical CAN frame over bits from start of frame to the end of data field before stuffing.
+func CRC15(bits Bits) uint16 {
+	return uint16(crcBits(table15, bits))
+}
+
+// CRC17 calculates CRC of a CAN FD frame with up to 16 data bytes over stuffed bits
+// from start of frame to the end of data field followed by the stuff count field.
+func CRC17(bits Bits) uint32 {
+	return uint32(crcBits(table17, bits))
+}
+
+// CRC21 calculates CRC of a CAN FD frame with more than 16 data bytes, see CRC17.
+func CRC21(bits Bits) uint32 {
+	return uint32(crcBits(table21, bits))
+}
+
+var (
+	// ErrCRC is returned by Decode for frames with CRC mismatch.
+	ErrCRC = errors.New("can: CRC error")
+	// ErrStuffCount is returned by Decode for CAN FD frames with stuff count not matching the number of stuff bits.
+	ErrStuffCount = errors.New("can: stuff count error")
+	// ErrFormat is returned for incomplete bit streams and fixed form bits with invalid value.
+	ErrFormat = errors.New("can: form error")
+)
+
+// Frame is a CAN or CAN FD data or remote frame.
+type Frame struct {
+	ID       uint32 // ID is the identifier, 11 bits for base format and 29 bits for extended format
+	Extended bool   // Extended selects the extended format with 29 bit identifier (IDE)
+	Remote   bool   // Remote selects classical remote frame (RTR)
+	FD       bool   // FD selects CAN FD frame format (FDF)
+	BRS      bool   // BRS is the bit rate switch of CAN FD frames
+	ESI      bool   // ESI is the error state indicator of CAN FD frames
+	DLC      uint8  // DLC is the data length code of remote frames, for data frames it is derived from Data unless set to 9-15 with 8 bytes of classical frame
+	Data     []byte // Data, up to 8 bytes for classical frames and up to 64 bytes for CAN FD frames
+}
+
+var fdLengths = [16]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 12, 16, 20, 24, 32, 48, 64}
+
+// DLCToLength returns number of data bytes of CAN FD frame for given data length code.
+// Classical frames carry at most 8 bytes.
+func DLCToLength(dlc uint8) int {
+	return fdLengths[dlc&0x0F]
+}
+
+// LengthToDLC returns data length code of CAN FD frame carrying n data bytes.
+func LengthToDLC(n int) (uint8, error) {
+	for dlc, length := range fdLengths {
+		if length == n {
+			return uint8(dlc), nil
+		}
+	}
+	return 0, fmt.Errorf("can: invalid data length %d", n)
+}
+
+// dlc validates the frame and returns its data length code
+func (f *Frame) dlc() (uint8, error) {
+	if (f.Extended && f.ID > 0x1FFFFFFF) || (!f.Extended && f.ID > 0x7FF) {
+		return 0, fmt.Errorf("can: invalid identifier 0x%X", f.ID)
+	}
+	if f.FD {
+		if f.Remote {
+			return 0, errors.New("can: CAN FD has no remote frames")
+		}
+		return LengthToDLC(len(f.Data))
+	}
+	if f.Remote {
+		if len(f.Data) != 0 || f.DLC > 15 {
+			return 0, errors.New("can: invalid remote frame")
+		}
+		return f.DLC, nil
+	}
+	if len(f.Data) > 8 {
+		return 0, fmt.Errorf("can: invalid data length %d", len(f.Data))
+	}
+	if len(f.Data) == 8 && f.DLC > 8 && f.DLC <= 15 {
+		return f.DLC, nil
+	}
+	return uint8(len(f.Data)), nil
+}
+
+// Bits returns the frame bits from start of frame to the end of data field before stuffing.
+func (f *Frame) Bits() (Bits, error) {
+	dlc, err := f.dlc()
+	if err != nil {
+		return nil, err
+	}
+	bits := make(Bits, 0, 64+8*len(f.Data))
+	bits = append(bits, 0) // SOF
+	if f.Extended {
+		bits = bits.appendUint(uint64(f.ID>>18), 11)
+		bits = append(bits, 1, 1) // SRR, IDE
+		bits = bits.appendUint(uint64(f.ID), 18)
+	} else {
+		bits = bits.appendUint(uint64(f.ID), 11)
+	}
+
+	switch {
+	case f.FD:
+		if !f.Extended {
+			bits = append(bits, 0, 0) // RRS, IDE
+		} else {
+			bits = append(bits, 0) // RRS
+		}
+		bits = append(bits, 1, 0, flag(f.BRS), flag(f.ESI)) // FDF, res, BRS, ESI
+	case f.Extended:
+		bits = append(bits, flag(f.Remote), 0, 0) // RTR, r1, r0
+	default:
+		bits = append(bits, flag(f.Remote), 0, 0) // RTR, IDE, r0
+	}
+
+	bits = bits.appendUint(uint64(dlc), 4)
+	for _, b := range f.Data {
+		bits = bits.appendUint(uint64(b), 8)
+	}
+	return bits, nil
+}
+
+func flag(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// fdCRCTable returns table used by CAN FD frame with given number of data bytes
+func fdCRCTable(dataLength int) (*crc.Table, int) {
+	if dataLength > 16 {
+		return table21, 21
+	}
+	return table17, 17
+}
+
+// stuffCount returns the stuff count field of CAN FD frame: number of dynamic stuff bits
+// modulo 8 in Gray code followed by even parity bit
+func stuffCount(stuffed int) Bits {
+	gray := uint64(stuffed%8) ^ uint64(stuffed%8)>>1
+	parity := byte(gray^gray>>1^gray>>2) & 1
+	return append(Bits{}.appendUint(gray, 3), parity)
+}
+
+// CRC returns the CRC of the frame, CRC-15 for classical frames and CRC-17 or CRC-21 for CAN FD frames.
+func (f *Frame) CRC() (uint32, error) {
+	bits, err := f.Bits()
+	if err != nil {
+		return 0, err
+	}
+	if !f.FD {
+		return uint32(CRC15(bits)), nil
+	}
+	stuffed, count := stuff(bits, false)
+	table, _ := fdCRCTable(len(f.Data))
+	return uint32(crcBits(table, append(stuffed, stuffCount(count)...))), nil
+}
+
+// Encode returns the bits of the frame as transmitted on the bus from start of frame
+// to the end of frame, including stuff bits. The acknowledge slot is recessive, as sent by the transmitter.
+func (f *Frame) Encode() (Bits, error) {
+	bits, err := f.Bits()
+	if err != nil {
+		return nil, err
+	}
+
+	var ret Bits
+	if !f.FD {
+		ret, _ = Stuff(bits.appendUint(uint64(CRC15(bits)), 15))
+	} else {
+		stuffed, count := stuff(bits, false)
+		table, width := fdCRCTable(len(f.Data))
+		sc := stuffCount(count)
+		fixed := sc.appendUint(crcBits(table, append(stuffed, sc...)), width)
+
+		// fixed stuff bit precedes stuff count and then every 4 bits
+		ret = stuffed
+		for i, bit := range fixed {
+			if i%4 == 0 {
+				ret = append(ret, ret[len(ret)-1]^1)
+			}
+			ret = append(ret, bit)
+		}
+	}
+	// CRC delimiter, ACK slot, ACK delimiter, end of frame
+	return append(ret, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1), nil
+}
+
+// Decode parses a frame from bits as transmitted on the bus starting with start of frame,
+// verifying stuffing, stuff count and CRC. Bits following the acknowledge delimiter are ignored.
+func Decode(bits Bits) (*Frame, error) {
+	d := &destuffer{bits: bits}
+	var f Frame
+	var unstuffed Bits
+	read := func(count int) uint64 {
+		v := d.uint(count)
+		unstuffed = unstuffed.appendUint(v, count)
+		return v
+	}
+
+	if read(1) != 0 && d.err == nil {
+		return nil, ErrFormat
+	}
+	f.ID = uint32(read(11))
+	rtr := read(1) // RTR, SRR or RRS
+	if f.Extended = read(1) == 1; f.Extended {
+		if rtr != 1 && d.err == nil {
+			return nil, ErrFormat
+		}
+		f.ID = f.ID<<18 | uint32(read(18))
+		rtr = read(1) // RTR or RRS
+	}
+	if f.FD = read(1) == 1; f.FD {
+		if rtr != 0 && d.err == nil {
+			return nil, ErrFormat
+		}
+		read(1) // res
+		f.BRS = read(1) == 1
+		f.ESI = read(1) == 1
+	} else {
+		f.Remote = rtr == 1
+		if f.Extended {
+			read(1) // r0
+		}
+	}
+	f.DLC = uint8(read(4))
+
+	length := DLCToLength(f.DLC)
+	if f.Remote {
+		length = 0
+	} else if !f.FD && length > 8 {
+		length = 8
+	}
+	f.Data = make([]byte, length)
+	for i := range f.Data {
+		f.Data[i] = byte(read(8))
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	if !f.FD {
+		dataEnd := len(unstuffed)
+		received := read(15)
+		d.skipStuff()
+		if d.err != nil {
+			return nil, d.err
+		}
+		if uint64(CRC15(unstuffed[:dataEnd])) != received {
+			return nil, ErrCRC
+		}
+	} else {
+		// fixed stuff bits precede stuff count and then every 4 bits
+		table, width := fdCRCTable(length)
+		crcEnd := d.pos
+		var fixed Bits
+		for i := 0; i < 4+width; i++ {
+			if i%4 == 0 {
+				last := d.bits[d.pos-1]
+				if bit := d.raw(); d.err == nil && bit == last {
+					return nil, ErrStuff
+				}
+			}
+			fixed = append(fixed, d.raw())
+		}
+		if d.err != nil {
+			return nil, d.err
+		}
+		sc, received := fixed[:4], fixed[4:].uint()
+		if crcBits(table, append(append(Bits{}, bits[:crcEnd]...), sc...)) != received {
+			return nil, ErrCRC
+		}
+		if sc.uint() != stuffCount(d.stuffed).uint() {
+			return nil, ErrStuffCount
+		}
+	}
+
+	// CRC delimiter, ACK slot, ACK delimiter
+	delimiters := bits[d.pos:]
+	if len(delimiters) < 3 || delimiters[0] != 1 || delimiters[2] != 1 {
+		return nil, ErrFormat
+	}
+
+	if !f.Remote && (f.FD || f.DLC <= 8) {
+		// derived from data length
+		f.DLC = 0
+	}
+	return &f, nil
+}
@@ -0,0 +1,249 @@
+package can_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ast-dd/crc/can"
+)
+
+// crcBitwise is the shift register implementation of the CAN specification
+func crcBitwise(bits can.Bits, width uint, poly, init uint32) uint32 {
+	reg := init
+	for _, bit := range bits {
+		next := uint32(bit) ^ (reg>>(width-1))&1
+		reg = (reg << 1) & (1<<width - 1)
+		if next != 0 {
+			reg ^= poly
+		}
+	}
+	return reg
+}
+
+func testFrames() []can.Frame {
+	data := []byte("\x00\xFF\x55\xAA\x01\x02\x03\x04\x05\x06\x07\x08\x09\x0A\x0B\x0C" + strings.Repeat("\x00\x00\xFF", 16))
+	frames := []can.Frame{
+		{ID: 0x000},
+		{ID: 0x7FF, Data: data[:1]},
+		{ID: 0x123, Data: data[:8]},
+		{ID: 0x555, Remote: true, DLC: 4},
+		{ID: 0x100, Data: data[:8], DLC: 15},
+		{ID: 0x1FFFFFFF, Extended: true, Data: data[:3]},
+		{ID: 0x0, Extended: true, Remote: true},
+	}
+	for _, length := range []int{0, 1, 8, 12, 16, 20, 24, 32, 48, 64} {
+		frames = append(frames,
+			can.Frame{ID: 0x7F0, FD: true, BRS: true, Data: data[:length]},
+			can.Frame{ID: 0x1ABCDEF0, Extended: true, FD: true, ESI: true, Data: data[64-length : 64]})
+	}
+	return frames
+}
+
+func TestStuff(t *testing.T) {
+	tests := []struct {
+		bits, stuffed string
+		count         int
+	}{
+		{"", "", 0},
+		{"0000", "0000", 0},
+		{"00000", "000001", 1},
+		{"0000011111", "000001111101", 2},
+		{"000001111", "00000111110", 2},
+		{"1111111111", "111110111110", 2},
+		{"0101010101", "0101010101", 0},
+	}
+	for _, tt := range tests {
+		bits, _ := can.ParseBits(tt.bits)
+		stuffed, count := can.Stuff(bits)
+		if stuffed.String() != tt.stuffed || count != tt.count {
+			t.Errorf("Stuff(%s) = %s, %d, want %s, %d", tt.bits, stuffed, count, tt.stuffed, tt.count)
+		}
+		unstuffed, count, err := can.Unstuff(stuffed)
+		if err != nil || unstuffed.String() != tt.bits || count != tt.count {
+			t.Errorf("Unstuff(%s) = %s, %d, %v, want %s, %d", stuffed, unstuffed, count, err, tt.bits, tt.count)
+		}
+	}
+
+	if _, _, err := can.Unstuff(can.Bits{0, 0, 0, 0, 0, 0}); err != can.ErrStuff {
+		t.Errorf("Unstuff(000000) error = %v, want %v", err, can.ErrStuff)
+	}
+	if _, err := can.ParseBits("01x"); err == nil {
+		t.Errorf("ParseBits(01x) returned no error")
+	}
+}
+
+func TestDLC(t *testing.T) {
+	for dlc := uint8(0); dlc < 16; dlc++ {
+		got, err := can.LengthToDLC(can.DLCToLength(dlc))
+		if err != nil || got != dlc {
+			t.Errorf("LengthToDLC(DLCToLength(%d)) = %d, %v", dlc, got, err)
+		}
+	}
+	if _, err := can.LengthToDLC(9); err == nil {
+		t.Errorf("LengthToDLC(9) returned no error")
+	}
+}
+
+func TestCRC(t *testing.T) {
+	bits := can.Bits{}
+	for _, b := range []byte("123456789") {
+		for i := 7; i >= 0; i-- {
+			bits = append(bits, b>>i&1)
+		}
+	}
+	if got, want := can.CRC15(bits), uint16(crcBitwise(bits, 15, 0x4599, 0)); got != want {
+		t.Errorf("CRC15() = 0x%X, want 0x%X", got, want)
+	}
+	if got, want := can.CRC17(bits), crcBitwise(bits, 17, 0x1685B, 1<<16); got != want {
+		t.Errorf("CRC17() = 0x%X, want 0x%X", got, want)
+	}
+	if got, want := can.CRC21(bits), crcBitwise(bits, 21, 0x102899, 1<<20); got != want {
+		t.Errorf("CRC21() = 0x%X, want 0x%X", got, want)
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for _, f := range testFrames() {
+		encoded, err := f.Encode()
+		if err != nil {
+			t.Fatalf("Encode(%+v) error = %v", f, err)
+		}
+		if !strings.HasSuffix(encoded.String(), "1111111111") {
+			t.Errorf("Encode(%+v) does not end with delimiters and end of frame", f)
+		}
+
+		// no runs of 6 equal bits up to the CRC delimiter
+		if strings.Contains(encoded[:len(encoded)-10].String(), "000000") || strings.Contains(encoded[:len(encoded)-10].String(), "111111") {
+			t.Errorf("Encode(%+v) = %s violates stuffing", f, encoded)
+		}
+
+		decoded, err := can.Decode(encoded)
+		if err != nil {
+			t.Fatalf("Decode(Encode(%+v)) error = %v", f, err)
+		}
+		want := f
+		if want.Data == nil {
+			want.Data = []byte{}
+		}
+		if !reflect.DeepEqual(*decoded, want) {
+			t.Errorf("Decode(Encode()) = %+v, want %+v", *decoded, want)
+		}
+
+		// CRC field as calculated by the shift register over the specified bit sequence
+		bits, _ := f.Bits()
+		wantCRC := crcBitwise(bits, 15, 0x4599, 0)
+		if f.FD {
+			stuffed, count := can.Stuff(bits)
+			if stuffed[len(stuffed)-1] != bits[len(bits)-1] {
+				// no dynamic stuff bit after the data field
+				stuffed, count = stuffed[:len(stuffed)-1], count-1
+			}
+			gray := count%8 ^ count%8>>1
+			sc := can.Bits{byte(gray >> 2 & 1), byte(gray >> 1 & 1), byte(gray & 1), byte((gray ^ gray>>1 ^ gray>>2) & 1)}
+			if len(f.Data) > 16 {
+				wantCRC = crcBitwise(append(stuffed, sc...), 21, 0x102899, 1<<20)
+			} else {
+				wantCRC = crcBitwise(append(stuffed, sc...), 17, 0x1685B, 1<<16)
+			}
+		}
+		if got, _ := f.CRC(); got != wantCRC {
+			t.Errorf("CRC(%+v) = 0x%X, want 0x%X", f, got, wantCRC)
+		}
+
+		// single bit errors
+		for i := 0; i < len(encoded)-10; i++ {
+			corrupted := append(can.Bits{}, encoded...)
+			corrupted[i] ^= 1
+			if got, err := can.Decode(corrupted); err == nil && reflect.DeepEqual(*got, want) {
+				t.Fatalf("Decode(%+v with bit %d flipped) returned no error", f, i)
+			}
+		}
+
+		if _, err = can.Decode(encoded[:len(encoded)-9]); err != can.ErrFormat {
+			t.Errorf("Decode(truncated %+v) error = %v, want %v", f, err, can.ErrFormat)
+		}
+	}
+}
+
+// stuffCountCodes is the stuff count coding table of ISO 11898-1:2015,
+// Gray coded number of stuff bits modulo 8 followed by even parity bit
+var stuffCountCodes = [8]string{"0000", "0011", "0110", "0101", "1100", "1111", "1010", "1001"}
+
+func TestEncodeFDCRCField(t *testing.T) {
+	seen := map[string]bool{}
+	for _, f := range testFrames() {
+		if !f.FD {
+			continue
+		}
+		encoded, _ := f.Encode()
+		bits, _ := f.Bits()
+
+		// CRC field of CAN FD frames has fixed length: 4 bit stuff count and 17 or 21 bit CRC,
+		// preceded by 6 or 7 fixed stuff bits, one before the stuff count and then after every 4 bits
+		fieldLength := 4 + 17 + 6
+		if len(f.Data) > 16 {
+			fieldLength = 4 + 21 + 7
+		}
+		end := len(encoded) - 10
+		field := encoded[end-fieldLength : end]
+		for i := 0; i < len(field); i += 5 {
+			if field[i] == encoded[end-fieldLength+i-1] {
+				t.Errorf("%+v: fixed stuff bit %d does not complement the preceding bit", f, i/5)
+			}
+		}
+
+		// dynamic stuff bits counted independently: frame bits up to the data field are those not following a run of 5
+		var stuffed int
+		prefix := encoded[:end-fieldLength]
+		for i, run := 1, 1; i < len(prefix); i++ {
+			if run == 5 {
+				stuffed, run = stuffed+1, 1
+				continue
+			}
+			if prefix[i] == prefix[i-1] {
+				run++
+			} else {
+				run = 1
+			}
+		}
+		if got := len(prefix) - stuffed; got != len(bits) {
+			t.Fatalf("%+v: %d bits before CRC field after removing %d stuff bits, want %d", f, got, stuffed, len(bits))
+		}
+		code := can.Bits{field[1], field[2], field[3], field[4]}.String()
+		if want := stuffCountCodes[stuffed%8]; code != want {
+			t.Errorf("%+v: stuff count of %d stuff bits = %s, want %s", f, stuffed, code, want)
+		}
+		seen[code] = true
+	}
+	if len(seen) < 4 {
+		t.Errorf("test frames cover only stuff count codes %v", seen)
+	}
+}
+
+func TestEncodeClassicalKnownAnswer(t *testing.T) {
+	// base data frame with identifier 0 and no data: 19 dominant bits, CRC-15 of zeros is zero,
+	// so the 34 dominant bits are stuffed after every 5 bits, followed by delimiters and end of frame
+	f := can.Frame{}
+	want := strings.Repeat("000001", 6) + "0000" + "1111111111"
+	got, err := f.Encode()
+	if err != nil || got.String() != want {
+		t.Errorf("Encode(%+v) = %s, %v, want %s", f, got, err, want)
+	}
+}
+
+func TestEncodeInvalid(t *testing.T) {
+	frames := []can.Frame{
+		{ID: 0x800},
+		{ID: 0x20000000, Extended: true},
+		{Data: make([]byte, 9)},
+		{FD: true, Data: make([]byte, 9)},
+		{FD: true, Remote: true},
+		{Remote: true, Data: []byte{1}},
+	}
+	for _, f := range frames {
+		if _, err := f.Encode(); err == nil {
+			t.Errorf("Encode(%+v) returned no error", f)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Package chunker splits a stream of data into variable size, content defined chunks.
+//
+// Chunk boundaries are placed where the CRC of a small window sliding over the data,
+// calculated using crc.RollingHash, has all bits selected by a mask equal to zero.
+// As boundaries depend only on the content of the window, inserting or removing data
+// changes only the chunks around the modification, which makes the chunks suitable
+// for deduplication.
+package chunker
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ast-dd/crc"
+)
+
+// Options configure a Chunker. Zero values are replaced by defaults.
+type Options struct {
+	MinSize    int             // MinSize is the minimal chunk size, default 2 KiB
+	AvgSize    int             // AvgSize is the expected average chunk size, must be a power of two, default 8 KiB
+	MaxSize    int             // MaxSize is the maximal chunk size, default 64 KiB
+	Window     int             // Window is the size of rolling window, default 64 bytes
+	Parameters *crc.Parameters // Parameters of CRC used for rolling window and chunks, default crc.CRC32
+}
+
+const (
+	defaultMinSize = 2 * 1024
+	defaultAvgSize = 8 * 1024
+	defaultMaxSize = 64 * 1024
+	defaultWindow  = 64
+)
+
+// Chunk is a single piece of data found by Chunker.
+type Chunk struct {
+	Offset int64  // Offset of the chunk in the stream
+	Data   []byte // Data of the chunk, valid only until the next call to Next
+	CRC    uint64 // CRC of chunk data
+}
+
+// Chunker splits data read from an io.Reader into chunks.
+type Chunker struct {
+	r       io.Reader
+	opts    Options
+	mask    uint64
+	table   *crc.Table
+	rolling *crc.RollingHash
+
+	buf    []byte
+	start  int // start of unconsumed data in buf
+	offset int64
+	err    error
+}
+
+// New creates a new Chunker reading from r.
+func New(r io.Reader, opts Options) (*Chunker, error) {
+	if opts.MinSize == 0 {
+		opts.MinSize = defaultMinSize
+	}
+	if opts.AvgSize == 0 {
+		opts.AvgSize = defaultAvgSize
+	}
+	if opts.MaxSize == 0 {
+		opts.MaxSize = defaultMaxSize
+	}
+	if opts.Window == 0 {
+		opts.Window = defaultWindow
+	}
+	if opts.Parameters == nil {
+		opts.Parameters = crc.CRC32
+	}
+
+	if opts.AvgSize&(opts.AvgSize-1) != 0 {
+		return nil, fmt.Errorf("average chunk size %d is not a power of two", opts.AvgSize)
+	}
+	if opts.Window < 1 || opts.MinSize < opts.Window || opts.AvgSize < opts.MinSize || opts.MaxSize < opts.AvgSize {
+		return nil, fmt.Errorf("invalid chunk sizes: window %d, min %d, avg %d, max %d", opts.Window, opts.MinSize, opts.AvgSize, opts.MaxSize)
+	}
+	if uint64(opts.AvgSize-1)>>opts.Parameters.Width != 0 {
+		return nil, fmt.Errorf("average chunk size %d too big for %d bit CRC", opts.AvgSize, opts.Parameters.Width)
+	}
+
+	table := crc.NewTable(opts.Parameters)
+	return &Chunker{
+		r:       r,
+		opts:    opts,
+		mask:    uint64(opts.AvgSize - 1),
+		table:   table,
+		rolling: crc.NewRollingHashWithTable(table, opts.Window),
+		buf:     make([]byte, 0, 2*opts.MaxSize),
+	}, nil
+}
+
+// Next returns the next chunk. It returns io.EOF when there are no more chunks.
+func (c *Chunker) Next() (Chunk, error) {
+	if err := c.fill(); err != nil {
+		return Chunk{}, err
+	}
+
+	data := c.buf[c.start:]
+	if len(data) > c.opts.MaxSize {
+		data = data[:c.opts.MaxSize]
+	}
+	data = data[:c.boundary(data)]
+	c.start += len(data)
+
+	ret := Chunk{Offset: c.offset, Data: data, CRC: c.table.CalculateCRC(data)}
+	c.offset += int64(len(data))
+	return ret, nil
+}
+
+// fill reads data until at least MaxSize bytes are buffered or the reader is exhausted
+func (c *Chunker) fill() error {
+	if len(c.buf)-c.start >= c.opts.MaxSize {
+		return nil
+	}
+	if c.start+c.opts.MaxSize > cap(c.buf) {
+		c.buf = c.buf[:copy(c.buf, c.buf[c.start:])]
+		c.start = 0
+	}
+	for len(c.buf)-c.start < c.opts.MaxSize && c.err == nil {
+		var n int
+		n, c.err = c.r.Read(c.buf[len(c.buf):cap(c.buf)])
+		c.buf = c.buf[:len(c.buf)+n]
+	}
+	if len(c.buf) == c.start {
+		if c.err == io.EOF {
+			return io.EOF
+		}
+		return c.err
+	}
+	if c.err != nil && c.err != io.EOF {
+		return c.err
+	}
+	return nil
+}
+
+// boundary returns the length of the chunk at the beginning of data
+func (c *Chunker) boundary(data []byte) int {
+	if len(data) <= c.opts.MinSize {
+		return len(data)
+	}
+
+	window := c.opts.Window
+	begin := c.opts.MinSize - window
+	c.rolling.Reset()
+	for i := begin; i < len(data); i++ {
+		var out byte
+		if i-begin >= window {
+			out = data[i-window]
+		}
+		c.rolling.Roll(data[i], out)
+		if i+1 >= c.opts.MinSize && c.rolling.CRC()&c.mask == 0 {
+			return i + 1
+		}
+	}
+	return len(data)
+}
@@ -0,0 +1,139 @@
+package chunker_test
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+
+	"github.com/ast-dd/crc"
+	"github.com/ast-dd/crc/chunker"
+)
+
+func chunks(t *testing.T, r io.Reader, opts chunker.Options) []chunker.Chunk {
+	t.Helper()
+	c, err := chunker.New(r, opts)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	var ret []chunker.Chunk
+	for {
+		chunk, err := c.Next()
+		if err == io.EOF {
+			return ret
+		}
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		chunk.Data = append([]byte{}, chunk.Data...)
+		ret = append(ret, chunk)
+	}
+}
+
+func TestChunker(t *testing.T) {
+	data := make([]byte, 1<<20)
+	rand.New(rand.NewSource(42)).Read(data)
+
+	opts := chunker.Options{MinSize: 1024, AvgSize: 4096, MaxSize: 16384}
+	got := chunks(t, iotest.HalfReader(bytes.NewReader(data)), opts)
+
+	var joined []byte
+	for i, chunk := range got {
+		if chunk.Offset != int64(len(joined)) {
+			t.Errorf("chunk %d Offset = %d, want %d", i, chunk.Offset, len(joined))
+		}
+		if len(chunk.Data) > opts.MaxSize || (len(chunk.Data) < opts.MinSize && i != len(got)-1) {
+			t.Errorf("chunk %d has size %d", i, len(chunk.Data))
+		}
+		if want := crc.CalculateCRC(crc.CRC32, chunk.Data); chunk.CRC != want {
+			t.Errorf("chunk %d CRC = 0x%X, want 0x%X", i, chunk.CRC, want)
+		}
+		joined = append(joined, chunk.Data...)
+	}
+	if !bytes.Equal(joined, data) {
+		t.Fatalf("chunks do not add up to original data")
+	}
+	if avg := len(data) / len(got); avg < opts.AvgSize/2 || avg > opts.AvgSize*2 {
+		t.Errorf("average chunk size %d, want about %d", avg, opts.AvgSize)
+	}
+
+	// boundaries depend on content, so inserting data only changes chunks around it
+	modified := append(append(append([]byte{}, data[:len(data)/2]...), "inserted"...), data[len(data)/2:]...)
+	seen := map[uint64]bool{}
+	for _, chunk := range got {
+		seen[chunk.CRC] = true
+	}
+	changed := 0
+	for _, chunk := range chunks(t, bytes.NewReader(modified), opts) {
+		if !seen[chunk.CRC] {
+			changed++
+		}
+	}
+	if changed > 3 {
+		t.Errorf("inserting data changed %d chunks, want at most 3", changed)
+	}
+}
+
+func TestChunkerSmallInputs(t *testing.T) {
+	if got := chunks(t, bytes.NewReader(nil), chunker.Options{}); len(got) != 0 {
+		t.Errorf("empty input produced %d chunks", len(got))
+	}
+
+	got := chunks(t, bytes.NewReader([]byte("123456789")), chunker.Options{Parameters: crc.CRC16MODBUS})
+	if len(got) != 1 || string(got[0].Data) != "123456789" || got[0].CRC != 0x4B37 {
+		t.Errorf("short input produced %+v", got)
+	}
+
+	// CRC-32 of a window of 16 zeros is 0xECBB4B55, which never matches the mask 0xFF, so chunks are cut at maximum size
+	got = chunks(t, bytes.NewReader(make([]byte, 10000)), chunker.Options{MinSize: 64, AvgSize: 256, MaxSize: 1024, Window: 16, Parameters: crc.CRC32})
+	if len(got) != 10 {
+		t.Errorf("zeros produced %d chunks, want 10", len(got))
+	}
+	for i, chunk := range got[:len(got)-1] {
+		if len(chunk.Data) != 1024 {
+			t.Errorf("chunk %d of zeros has size %d, want 1024", i, len(chunk.Data))
+		}
+	}
+	if last := got[len(got)-1]; len(last.Data) != 10000%1024 {
+		t.Errorf("last chunk of zeros has size %d, want %d", len(last.Data), 10000%1024)
+	}
+}
+
+func TestChunkerOptions(t *testing.T) {
+	for _, opts := range []chunker.Options{
+		{AvgSize: 3000},
+		{MinSize: 16, Window: 32},
+		{MinSize: 8192, AvgSize: 4096},
+		{AvgSize: 65536, MaxSize: 32768},
+		{AvgSize: 1024, MinSize: 128, Parameters: crc.CRC8},
+	} {
+		if _, err := chunker.New(nil, opts); err == nil {
+			t.Errorf("New(%+v) accepted invalid options", opts)
+		}
+	}
+}
+
+func TestChunkerReadError(t *testing.T) {
+	c, err := chunker.New(iotest.ErrReader(io.ErrUnexpectedEOF), chunker.Options{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err = c.Next(); err != io.ErrUnexpectedEOF {
+		t.Errorf("Next() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func BenchmarkChunker(b *testing.B) {
+	data := make([]byte, 1<<20)
+	rand.New(rand.NewSource(42)).Read(data)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		c, _ := chunker.New(bytes.NewReader(data), chunker.Options{})
+		for {
+			if _, err := c.Next(); err != nil {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ast-dd/crc/codegen"
+)
+
+func genCommand(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("crc gen", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var (
+		algorithm = flags.String("a", "CRC32", "CRC algorithm `name`, see crc -list")
+		inline    = flags.String("params", "", "inline RevEng style `definition`")
+		lang      = flags.String("lang", "c", "output `language`: c, go or rust")
+		variant   = flags.String("variant", "byte", "algorithm `variant`: byte, nibble or bitwise")
+		name      = flags.String("name", "", "`prefix` of generated identifiers, derived from algorithm name by default")
+		pkg       = flags.String("package", "crc", "`name` of generated Go package")
+		output    = flags.String("o", "", "write code to `file` instead of standard output")
+	)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: crc gen [flags]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return usageError{err}
+	}
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return usageError{errors.New("unexpected arguments")}
+	}
+
+	params, _, err := parseAlgorithm(*algorithm, *inline)
+	if err != nil {
+		return usageError{err}
+	}
+	opts := codegen.Options{Name: *name, Package: *pkg}
+	switch strings.ToLower(*lang) {
+	case "c":
+		opts.Language = codegen.C
+	case "go":
+		opts.Language = codegen.Go
+	case "rust", "rs":
+		opts.Language = codegen.Rust
+	default:
+		return usageError{fmt.Errorf("unknown language %q", *lang)}
+	}
+	switch strings.ToLower(*variant) {
+	case "byte":
+		opts.Variant = codegen.ByteTable
+	case "nibble":
+		opts.Variant = codegen.NibbleTable
+	case "bitwise":
+		opts.Variant = codegen.Bitwise
+	default:
+		return usageError{fmt.Errorf("unknown variant %q", *variant)}
+	}
+
+	if *output == "" {
+		return codegen.Generate(stdout, params, opts)
+	}
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err = codegen.Generate(f, params, opts); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGen(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		want     string
+		wantCode int
+	}{
+		{"c", []string{"gen", "-a", "CRC16MODBUS"}, "static inline uint16_t crc16modbus(const void *data, size_t len)", 0},
+		{"go", []string{"gen", "-a", "CRC8", "-lang", "go", "-variant", "nibble", "-package", "sensor"}, "package sensor\n", 0},
+		{"rust", []string{"gen", "-params", "width=5 poly=0x05 init=0x1f refin=true refout=true xorout=0x1f", "-lang", "rust", "-variant", "bitwise", "-name", "crc5_usb"}, "pub fn crc5_usb(data: &[u8]) -> u8 {", 0},
+		{"unknown language", []string{"gen", "-lang", "cobol"}, "", 2},
+		{"unknown variant", []string{"gen", "-variant", "quantum"}, "", 2},
+		{"unknown algorithm", []string{"gen", "-a", "nope"}, "", 2},
+		{"arguments", []string{"gen", "file"}, "", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if code := run(tt.args, nil, &stdout, &stderr); code != tt.wantCode {
+				t.Errorf("run() = %d, want %d (stderr %q)", code, tt.wantCode, stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.want) {
+				t.Errorf("run() output does not contain %q", tt.want)
+			}
+		})
+	}
+
+	output := filepath.Join(t.TempDir(), "crc32.h")
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"gen", "-o", output}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("run() = %d, stderr %q", code, stderr.String())
+	}
+	if data, err := os.ReadFile(output); err != nil || !bytes.Contains(data, []byte("0xEDB88320")) {
+		t.Errorf("gen -o wrote %q, %v", data, err)
+	}
+}
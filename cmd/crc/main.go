@@ -0,0 +1,58 @@
+// Command crc calculates CRC checksums of files, standard input, hex strings or literal text
+// using any algorithm known to package github.com/ast-dd/crc or defined inline in RevEng format.
+//
+// Usage:
+//
+//	crc [flags] [file ...]
+//	crc manifest create [flags] dir
+//	crc manifest verify [flags] dir
+//	crc gen [flags]
+//
+// With no files, or when file is -, standard input is read.
+// The manifest subcommands create and verify checksum manifests in SFV or generic format,
+// verification reports missing, changed and extra files and exits with non-zero code on any difference.
+// The gen subcommand emits self-contained C, Go or Rust implementation of the algorithm.
+// Run "crc -h" or "crc manifest create -h" for the list of flags.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command with given arguments and returns the exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	command := sumCommand
+	if len(args) > 0 {
+		switch args[0] {
+		case "manifest":
+			command, args = manifestCommand, args[1:]
+		case "gen":
+			command, args = genCommand, args[1:]
+		}
+	}
+	if err := command(args, stdin, stdout, stderr); err != nil {
+		fmt.Fprintln(stderr, "crc:", err)
+		return exitCode(err)
+	}
+	return 0
+}
+
+// usageError marks errors caused by invalid command line
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string { return e.err.Error() }
+
+func exitCode(err error) int {
+	if _, ok := err.(usageError); ok {
+		return 2
+	}
+	return 1
+}
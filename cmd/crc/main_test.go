@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "data")
+	if err := os.WriteFile(file, []byte("123456789"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		stdin    string
+		wantOut  string
+		wantCode int
+	}{
+		{"stdin", nil, "123456789", "CBF43926  -\n", 0},
+		{"file", []string{file}, "", "CBF43926  " + file + "\n", 0},
+		{"text", []string{"-a", "crc16modbus", "-text", "123456789"}, "", "4B37  -text\n", 0},
+		{"hex", []string{"-a", "CRC8", "-hex", "31 32 33 34 35 36 37 38 39"}, "", "F4  -hex\n", 0},
+		{"little endian", []string{"-a", "CRC16MODBUS", "-order", "little"}, "123456789", "374B  -\n", 0},
+		{"decimal", []string{"-format", "dec"}, "123456789", "3421780262  -\n", 0},
+		{"raw", []string{"-a", "CRC16MODBUS", "-format", "raw", "-order", "little"}, "123456789", "\x37\x4B", 0},
+		{"json", []string{"-a", "x25", "-format", "json"}, "123456789", `{"name":"-","algorithm":"X25","crc":36974,"hex":"906E"}` + "\n", 0},
+		{"inline", []string{"-params", "width=3 poly=0x3 xorout=0x7", "-format", "dec"}, "123456789", "4  -\n", 0},
+		{"unknown algorithm", []string{"-a", "nope"}, "", "", 2},
+		{"invalid inline", []string{"-params", "width=3"}, "", "", 2},
+		{"unknown format", []string{"-format", "nope"}, "", "", 2},
+		{"unknown order", []string{"-order", "nope"}, "", "", 2},
+		{"invalid hex", []string{"-hex", "xyz"}, "", "", 2},
+		{"missing file", []string{filepath.Join(dir, "missing"), file}, "", "CBF43926  " + file + "\n", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Errorf("run() = %d, want %d (stderr %q)", code, tt.wantCode, stderr.String())
+			}
+			if got := stdout.String(); got != tt.wantOut {
+				t.Errorf("run() output = %q, want %q", got, tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestRunList(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"-list"}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("run() = %d, want 0", code)
+	}
+	if !strings.Contains(stdout.String(), "\nCRC16MODBUS\n") {
+		t.Errorf("run() output does not list CRC16MODBUS")
+	}
+}
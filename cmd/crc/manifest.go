@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ast-dd/crc/manifest"
+)
+
+func manifestCommand(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "create":
+			return manifestCreate(args[1:], stdout, stderr)
+		case "verify":
+			return manifestVerify(args[1:], stdin, stdout, stderr)
+		}
+	}
+	fmt.Fprintln(stderr, "Usage: crc manifest create|verify [flags] dir")
+	return usageError{errors.New("missing manifest subcommand")}
+}
+
+func manifestCreate(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("crc manifest create", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var (
+		algorithm = flags.String("a", "CRC32", "CRC algorithm `name`, see crc -list")
+		inline    = flags.String("params", "", "inline RevEng style `definition`")
+		sfv       = flags.Bool("sfv", false, "write SFV format, requires CRC32 algorithm")
+		output    = flags.String("o", "", "write manifest to `file` instead of standard output")
+	)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: crc manifest create [flags] dir")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return usageError{err}
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return usageError{errors.New("exactly one directory expected")}
+	}
+	params, _, err := parseAlgorithm(*algorithm, *inline)
+	if err != nil {
+		return usageError{err}
+	}
+	format := manifest.Generic
+	if *sfv {
+		format = manifest.SFV
+	}
+
+	root := flags.Arg(0)
+	m, err := manifest.Create(context.Background(), root, params)
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		return m.Write(stdout, format)
+	}
+
+	// do not list the manifest itself if it is written into the tree
+	if rel, ok := relativeTo(root, *output); ok {
+		entries := m.Entries[:0]
+		for _, e := range m.Entries {
+			if e.Path != rel {
+				entries = append(entries, e)
+			}
+		}
+		m.Entries = entries
+	}
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err = m.Write(f, format); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func manifestVerify(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("crc manifest verify", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var (
+		input       = flags.String("m", "-", "read manifest from `file`, - for standard input")
+		ignoreExtra = flags.Bool("ignore-extra", false, "do not report files missing in manifest")
+	)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: crc manifest verify [flags] dir")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return usageError{err}
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return usageError{errors.New("exactly one directory expected")}
+	}
+
+	var r io.Reader = stdin
+	if *input != "-" {
+		f, err := os.Open(*input)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	m, err := manifest.Parse(r)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *input, err)
+	}
+
+	root := flags.Arg(0)
+	report, err := manifest.Verify(context.Background(), root, m)
+	if err != nil {
+		return err
+	}
+	rel, inTree := relativeTo(root, *input)
+	failed := false
+	for _, path := range report.Missing {
+		fmt.Fprintln(stdout, "MISSING", path)
+		failed = true
+	}
+	for _, path := range report.Changed {
+		fmt.Fprintln(stdout, "CHANGED", path)
+		failed = true
+	}
+	if !*ignoreExtra {
+		for _, path := range report.Extra {
+			if inTree && path == rel {
+				continue
+			}
+			fmt.Fprintln(stdout, "EXTRA", path)
+			failed = true
+		}
+	}
+	if failed {
+		return errors.New("manifest verification failed")
+	}
+	return nil
+}
+
+// relativeTo returns slash separated path of file relative to root if file is inside root
+func relativeTo(root, file string) (string, bool) {
+	if file == "-" {
+		return "", false
+	}
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return "", false
+	}
+	absFile, err := filepath.Abs(file)
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(absRoot, absFile)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestManifest(t *testing.T) {
+	root := t.TempDir()
+	for name, content := range map[string]string{"a": "123456789", "b": "12345678901234567890", "c": "x"} {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	manifestFile := filepath.Join(root, "files.sfv")
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"manifest", "create", "-sfv", "-o", manifestFile, root}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("manifest create = %d, stderr %q", code, stderr.String())
+	}
+	data, _ := os.ReadFile(manifestFile)
+	if got, want := string(data), "a CBF43926\nb 906319F2\nc 8CDC1683\n"; got != want {
+		t.Errorf("manifest create wrote %q, want %q", got, want)
+	}
+
+	if code := run([]string{"manifest", "verify", "-m", manifestFile, root}, nil, &stdout, &stderr); code != 0 {
+		t.Errorf("manifest verify = %d, output %q, stderr %q", code, stdout.String(), stderr.String())
+	}
+
+	os.WriteFile(filepath.Join(root, "a"), []byte("changed"), 0o644)
+	os.Remove(filepath.Join(root, "b"))
+	os.WriteFile(filepath.Join(root, "d"), nil, 0o644)
+	stdout.Reset()
+	if code := run([]string{"manifest", "verify", "-m", manifestFile, root}, nil, &stdout, &stderr); code != 1 {
+		t.Errorf("manifest verify = %d, want 1", code)
+	}
+	if got, want := stdout.String(), "MISSING b\nCHANGED a\nEXTRA d\n"; got != want {
+		t.Errorf("manifest verify output = %q, want %q", got, want)
+	}
+
+	// generic format through stdout and stdin
+	stdout.Reset()
+	if code := run([]string{"manifest", "create", "-a", "CRC16MODBUS", root}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("manifest create = %d, stderr %q", code, stderr.String())
+	}
+	if !strings.HasPrefix(stdout.String(), "# crc manifest CRC16MODBUS\n") {
+		t.Errorf("manifest create output = %q", stdout.String())
+	}
+	generic := stdout.String()
+	stdout.Reset()
+	if code := run([]string{"manifest", "verify", "-ignore-extra", root}, strings.NewReader(generic), &stdout, &stderr); code != 0 {
+		t.Errorf("manifest verify = %d, output %q, stderr %q", code, stdout.String(), stderr.String())
+	}
+
+	for _, args := range [][]string{
+		{"manifest"},
+		{"manifest", "create"},
+		{"manifest", "create", "-sfv", "-a", "CRC16MODBUS", root},
+		{"manifest", "verify", "-m", filepath.Join(root, "missing"), root},
+	} {
+		if code := run(args, nil, &stdout, &stderr); code == 0 {
+			t.Errorf("run(%q) succeeded", args)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ast-dd/crc"
+)
+
+// input is a single piece of data to checksum
+type input struct {
+	name string
+	open func() (io.ReadCloser, error)
+}
+
+// result is the JSON representation of a calculated CRC
+type result struct {
+	Name      string `json:"name"`
+	Algorithm string `json:"algorithm"`
+	CRC       uint64 `json:"crc"`
+	Hex       string `json:"hex"`
+}
+
+func sumCommand(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("crc", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var (
+		algorithm = flags.String("a", "CRC32", "CRC algorithm `name`, see -list")
+		inline    = flags.String("params", "", "inline RevEng style `definition`, e.g. \"width=16 poly=0x1021 init=0xffff\"")
+		hexInput  = flags.String("hex", "", "checksum bytes given as hex `string` instead of files")
+		text      = flags.String("text", "", "checksum literal `text` instead of files")
+		format    = flags.String("format", "hex", "output `format`: hex, dec, raw or json")
+		order     = flags.String("order", "big", "byte `order` of hex and raw output: big or little")
+		list      = flags.Bool("list", false, "list known algorithms and exit")
+	)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: crc [flags] [file ...]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return usageError{err}
+	}
+
+	if *list {
+		for _, name := range crc.ParametersNames() {
+			fmt.Fprintln(stdout, name)
+		}
+		return nil
+	}
+
+	params, name, err := parseAlgorithm(*algorithm, *inline)
+	if err != nil {
+		return usageError{err}
+	}
+	byteOrder, err := parseByteOrder(*order)
+	if err != nil {
+		return usageError{err}
+	}
+	out, err := newFormatter(*format, params, name, byteOrder, stdout)
+	if err != nil {
+		return usageError{err}
+	}
+
+	var inputs []input
+	if *hexInput != "" {
+		data, err := hex.DecodeString(strings.TrimPrefix(strings.Join(strings.Fields(*hexInput), ""), "0x"))
+		if err != nil {
+			return usageError{fmt.Errorf("invalid hex string: %w", err)}
+		}
+		inputs = append(inputs, bytesInput("-hex", data))
+	}
+	if *text != "" {
+		inputs = append(inputs, bytesInput("-text", []byte(*text)))
+	}
+	for _, path := range flags.Args() {
+		inputs = append(inputs, fileInput(path, stdin))
+	}
+	if len(inputs) == 0 {
+		inputs = append(inputs, fileInput("-", stdin))
+	}
+
+	var failed error
+	for _, in := range inputs {
+		checksum, err := sumInput(in, params)
+		if err != nil {
+			fmt.Fprintln(stderr, "crc:", err)
+			failed = errors.New("some inputs could not be read")
+			continue
+		}
+		if err = out(in.name, checksum); err != nil {
+			return err
+		}
+	}
+	return failed
+}
+
+// parseAlgorithm returns parameters either parsed from inline definition or looked up by name
+func parseAlgorithm(name, inline string) (*crc.Parameters, string, error) {
+	if inline != "" {
+		params, err := crc.ParseParameters(inline)
+		return params, "custom", err
+	}
+	params, err := crc.GetParameters(name)
+	if err != nil {
+		return nil, "", err
+	}
+	// report the name as selected, not the first alias of the same parameters
+	return params, strings.ToUpper(name), nil
+}
+
+func parseByteOrder(s string) (crc.ByteOrder, error) {
+	switch strings.ToLower(s) {
+	case "big", "be":
+		return crc.BigEndian, nil
+	case "little", "le":
+		return crc.LittleEndian, nil
+	}
+	return 0, fmt.Errorf("unknown byte order %q", s)
+}
+
+func bytesInput(name string, data []byte) input {
+	return input{name: name, open: func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(string(data))), nil
+	}}
+}
+
+func fileInput(path string, stdin io.Reader) input {
+	if path == "-" {
+		return input{name: path, open: func() (io.ReadCloser, error) {
+			return io.NopCloser(stdin), nil
+		}}
+	}
+	return input{name: path, open: func() (io.ReadCloser, error) {
+		return os.Open(path)
+	}}
+}
+
+func sumInput(in input, params *crc.Parameters) (uint64, error) {
+	r, err := in.open()
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	ret, err := crc.SumReader(context.Background(), r, params)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", in.name, err)
+	}
+	return ret, nil
+}
+
+// checksumBytes serializes checksum into the smallest number of bytes able to hold it
+func checksumBytes(checksum uint64, width uint, order crc.ByteOrder) []byte {
+	size := int(width+7) / 8
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, checksum)
+	buf = buf[8-size:]
+	if order == crc.LittleEndian {
+		for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+			buf[i], buf[j] = buf[j], buf[i]
+		}
+	}
+	return buf
+}
+
+// newFormatter returns a function writing calculated checksums to w in given format
+func newFormatter(format string, params *crc.Parameters, algorithm string, order crc.ByteOrder, w io.Writer) (func(name string, checksum uint64) error, error) {
+	switch strings.ToLower(format) {
+	case "hex":
+		return func(name string, checksum uint64) error {
+			_, err := fmt.Fprintf(w, "%X  %s\n", checksumBytes(checksum, params.Width, order), name)
+			return err
+		}, nil
+	case "dec":
+		return func(name string, checksum uint64) error {
+			_, err := fmt.Fprintf(w, "%s  %s\n", strconv.FormatUint(checksum, 10), name)
+			return err
+		}, nil
+	case "raw":
+		return func(name string, checksum uint64) error {
+			_, err := w.Write(checksumBytes(checksum, params.Width, order))
+			return err
+		}, nil
+	case "json":
+		enc := json.NewEncoder(w)
+		return func(name string, checksum uint64) error {
+			return enc.Encode(result{
+				Name:      name,
+				Algorithm: algorithm,
+				CRC:       checksum,
+				Hex:       fmt.Sprintf("%X", checksumBytes(checksum, params.Width, order)),
+			})
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q", format)
+}
@@ -0,0 +1,298 @@
+// Package codegen emits self-contained CRC implementations in C, Go and Rust
+// computing exactly the same CRC as package crc does for given Parameters.
+//
+// Lookup tables are precomputed using crc.NewTable, so generated code does not
+// need any initialization. Non reflected algorithms narrower than 8 bits are
+// calculated in a register left aligned to 8 bits, which is shifted back when
+// the CRC is finalized.
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/ast-dd/crc"
+)
+
+// Language of the generated code
+type Language int
+
+const (
+	C Language = iota
+	Go
+	Rust
+)
+
+// String returns the name of the language
+func (l Language) String() string {
+	switch l {
+	case C:
+		return "C"
+	case Go:
+		return "Go"
+	case Rust:
+		return "Rust"
+	}
+	return fmt.Sprintf("Language(%d)", int(l))
+}
+
+// Variant of the generated algorithm, trading code size for speed
+type Variant int
+
+const (
+	// ByteTable processes a byte at a time using 256 entry lookup table
+	ByteTable Variant = iota
+	// NibbleTable processes 4 bits at a time using 16 entry lookup table
+	NibbleTable
+	// Bitwise processes a bit at a time without any lookup table
+	Bitwise
+)
+
+// String returns the name of the variant
+func (v Variant) String() string {
+	switch v {
+	case ByteTable:
+		return "ByteTable"
+	case NibbleTable:
+		return "NibbleTable"
+	case Bitwise:
+		return "Bitwise"
+	}
+	return fmt.Sprintf("Variant(%d)", int(v))
+}
+
+// Options control the generated code.
+type Options struct {
+	Language Language
+	Variant  Variant
+	// Name is used as prefix of generated identifiers. Defaults to lower case algorithm name
+	// for C and Rust and to upper case algorithm name for Go.
+	Name string
+	// Package is the name of generated Go package. Defaults to "crc".
+	Package string
+}
+
+// Model describes the CRC register as used by generated code. Generate uses it
+// to fill in the code templates; it is exported to allow checking generated tables.
+type Model struct {
+	Width      uint   // Width of the CRC
+	RegWidth   uint   // RegWidth is width of the register, at least 8 for non reflected algorithms
+	Shift      uint   // Shift is the number of bits register is left aligned by
+	Reflected  bool   // Reflected indicates whether register shifts to the right
+	ReflectOut bool   // ReflectOut indicates whether register has to be reflected when finalizing
+	Poly       uint64 // Poly is polynomial in register form
+	Init       uint64 // Init is initial register value
+	XorOut     uint64 // XorOut is a value for final xor
+	Table      []uint64
+}
+
+// NewModel creates Model for given parameters and variant.
+// Table holds 256 entries for ByteTable, 16 for NibbleTable and is empty for Bitwise.
+func NewModel(crcParams *crc.Parameters, variant Variant) (*Model, error) {
+	if crcParams.Width == 0 || crcParams.Width > 64 {
+		return nil, fmt.Errorf("unsupported width %d", crcParams.Width)
+	}
+	m := &Model{
+		Width:      crcParams.Width,
+		RegWidth:   crcParams.Width,
+		Reflected:  crcParams.ReflectIn,
+		ReflectOut: crcParams.ReflectIn != crcParams.ReflectOut,
+		XorOut:     crcParams.FinalXor & mask(crcParams.Width),
+	}
+	if m.Reflected {
+		m.Poly = reflect(crcParams.Polynomial, m.Width)
+		m.Init = reflect(crcParams.Init, m.Width)
+	} else {
+		if m.Width < 8 {
+			m.Shift = 8 - m.Width
+			m.RegWidth = 8
+		}
+		m.Poly = (crcParams.Polynomial << m.Shift) & m.Mask()
+		m.Init = (crcParams.Init << m.Shift) & m.Mask()
+	}
+
+	switch variant {
+	case ByteTable:
+		entries := crc.NewTable(crcParams).Entries()
+		m.Table = make([]uint64, len(entries))
+		for i, e := range entries {
+			m.Table[i] = (e << m.Shift) & m.Mask()
+		}
+	case NibbleTable:
+		m.Table = make([]uint64, 16)
+		for i := range m.Table {
+			m.Table[i] = m.step(uint64(i), 4)
+		}
+	case Bitwise:
+	default:
+		return nil, fmt.Errorf("unknown variant %d", variant)
+	}
+	return m, nil
+}
+
+// Mask returns mask of all register bits
+func (m *Model) Mask() uint64 {
+	return mask(m.RegWidth)
+}
+
+// WidthMask returns mask of all CRC bits
+func (m *Model) WidthMask() uint64 {
+	return mask(m.Width)
+}
+
+// Top returns the top bit of non reflected register
+func (m *Model) Top() uint64 {
+	return uint64(1) << (m.RegWidth - 1)
+}
+
+// step feeds bits of v into zero register bit by bit
+func (m *Model) step(v uint64, bits uint) uint64 {
+	r := v
+	if !m.Reflected {
+		r = v << (m.RegWidth - bits)
+	}
+	for i := uint(0); i < bits; i++ {
+		if m.Reflected {
+			if r&1 != 0 {
+				r = (r >> 1) ^ m.Poly
+			} else {
+				r >>= 1
+			}
+		} else {
+			if r&m.Top() != 0 {
+				r = (r << 1) ^ m.Poly
+			} else {
+				r <<= 1
+			}
+			r &= m.Mask()
+		}
+	}
+	return r
+}
+
+func mask(width uint) uint64 {
+	return ^uint64(0) >> (64 - width)
+}
+
+// reflect reverses order of last count bits
+func reflect(in uint64, count uint) uint64 {
+	var ret uint64
+	for i := uint(0); i < count; i++ {
+		if in&(uint64(1)<<i) != 0 {
+			ret |= uint64(1) << (count - 1 - i)
+		}
+	}
+	return ret
+}
+
+// templateData is passed to code templates
+type templateData struct {
+	*Model
+	Name    string
+	Package string
+	Params  string
+	Check   string
+	Variant Variant
+	Type    string
+	Digits  int
+}
+
+// Hex formats v as a hex literal with number of digits matching register width
+func (d *templateData) Hex(v uint64) string {
+	return fmt.Sprintf("0x%0*X", d.Digits, v)
+}
+
+// Rows formats lookup table entries in rows
+func (d *templateData) Rows() []string {
+	perRow := 8
+	if d.Digits <= 4 {
+		perRow = 16
+	}
+	if d.Digits > 8 {
+		perRow = 4
+	}
+	var rows []string
+	for i := 0; i < len(d.Table); i += perRow {
+		var b strings.Builder
+		for j := i; j < i+perRow && j < len(d.Table); j++ {
+			if j > i {
+				b.WriteByte(' ')
+			}
+			b.WriteString(d.Hex(d.Table[j]))
+			b.WriteByte(',')
+		}
+		rows = append(rows, b.String())
+	}
+	return rows
+}
+
+// Generate writes implementation of the CRC algorithm specified by crcParams to w.
+func Generate(w io.Writer, crcParams *crc.Parameters, opts Options) error {
+	m, err := NewModel(crcParams, opts.Variant)
+	if err != nil {
+		return err
+	}
+
+	name := opts.Name
+	if name == "" {
+		name, err = crc.GetParametersName(crcParams)
+		if err != nil {
+			name = fmt.Sprintf("CRC%d", crcParams.Width)
+		}
+		if opts.Language == Go {
+			name = strings.ToUpper(name)
+		} else {
+			name = strings.ToLower(name)
+		}
+	}
+	pkg := opts.Package
+	if pkg == "" {
+		pkg = "crc"
+	}
+
+	size := 8
+	for size < int(m.RegWidth) {
+		size *= 2
+	}
+	data := &templateData{
+		Model:   m,
+		Name:    name,
+		Package: pkg,
+		Params:  crc.FormatParameters(crcParams),
+		Variant: opts.Variant,
+		Digits:  int(m.RegWidth+3) / 4,
+	}
+	data.Check = data.Hex(crc.CalculateCRC(crcParams, []byte("123456789")))
+
+	var tmpl *template.Template
+	switch opts.Language {
+	case C:
+		data.Type = fmt.Sprintf("uint%d_t", size)
+		tmpl = cTemplate
+	case Go:
+		data.Type = fmt.Sprintf("uint%d", size)
+		tmpl = goTemplate
+	case Rust:
+		data.Type = fmt.Sprintf("u%d", size)
+		tmpl = rustTemplate
+	default:
+		return fmt.Errorf("unknown language %d", opts.Language)
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+	code := buf.Bytes()
+	if opts.Language == Go {
+		if code, err = format.Source(code); err != nil {
+			return err
+		}
+	}
+	_, err = w.Write(code)
+	return err
+}
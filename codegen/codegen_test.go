@@ -0,0 +1,206 @@
+package codegen_test
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ast-dd/crc"
+	"github.com/ast-dd/crc/codegen"
+)
+
+// testParameters returns all known parameters plus a few unusual widths
+func testParameters() map[string]*crc.Parameters {
+	ret := map[string]*crc.Parameters{
+		"CRC3GSM":   {Width: 3, Polynomial: 0x03, FinalXor: 0x7},
+		"CRC3ROHC":  {Width: 3, Polynomial: 0x03, Init: 0x7, ReflectIn: true, ReflectOut: true},
+		"CRC7":      {Width: 7, Polynomial: 0x09},
+		"CRC12UMTS": {Width: 12, Polynomial: 0x80f, ReflectOut: true},
+		"CRC24BLE":  {Width: 24, Polynomial: 0x65b, Init: 0x555555, ReflectIn: true, ReflectOut: true},
+		"CRC31":     {Width: 31, Polynomial: 0x04c11db7, Init: 0x7fffffff, FinalXor: 0x7fffffff},
+	}
+	for _, name := range crc.ParametersNames() {
+		ret[name], _ = crc.GetParameters(name)
+	}
+	return ret
+}
+
+// simulate calculates CRC the same way generated code does
+func simulate(m *codegen.Model, variant codegen.Variant, data []byte) uint64 {
+	r := m.Init
+	for _, b := range data {
+		switch {
+		case variant == codegen.ByteTable && m.Reflected:
+			r = m.Table[byte(r)^b] ^ (r >> 8)
+		case variant == codegen.ByteTable:
+			r = (m.Table[byte(r>>(m.RegWidth-8))^b] ^ (r << 8)) & m.Mask()
+		case variant == codegen.NibbleTable && m.Reflected:
+			r = m.Table[(r^uint64(b))&0x0F] ^ (r >> 4)
+			r = m.Table[(r^uint64(b>>4))&0x0F] ^ (r >> 4)
+		case variant == codegen.NibbleTable:
+			r = (m.Table[((r>>(m.RegWidth-4))^uint64(b>>4))&0x0F] ^ (r << 4)) & m.Mask()
+			r = (m.Table[((r>>(m.RegWidth-4))^uint64(b))&0x0F] ^ (r << 4)) & m.Mask()
+		case m.Reflected:
+			r ^= uint64(b)
+			for i := 0; i < 8; i++ {
+				if r&1 != 0 {
+					r = (r >> 1) ^ m.Poly
+				} else {
+					r >>= 1
+				}
+			}
+		default:
+			r ^= uint64(b) << (m.RegWidth - 8)
+			for i := 0; i < 8; i++ {
+				if r&m.Top() != 0 {
+					r = (r << 1) ^ m.Poly
+				} else {
+					r <<= 1
+				}
+			}
+			r &= m.Mask()
+		}
+	}
+	r >>= m.Shift
+	if m.ReflectOut {
+		var reflected uint64
+		for i := uint(0); i < m.Width; i++ {
+			reflected = (reflected << 1) | (r & 1)
+			r >>= 1
+		}
+		r = reflected
+	}
+	return (r ^ m.XorOut) & m.WidthMask()
+}
+
+func TestModel(t *testing.T) {
+	data := []byte("Introduction on CRC calculations")
+	for name, params := range testParameters() {
+		want := crc.CalculateCRC(params, data)
+		for _, variant := range []codegen.Variant{codegen.ByteTable, codegen.NibbleTable, codegen.Bitwise} {
+			m, err := codegen.NewModel(params, variant)
+			if err != nil {
+				t.Fatalf("NewModel(%s, %v) error = %v", name, variant, err)
+			}
+			if got := simulate(m, variant, data); got != want {
+				t.Errorf("%s %v calculated 0x%X, want 0x%X", name, variant, got, want)
+			}
+		}
+	}
+}
+
+var tableRe = regexp.MustCompile(`(?s)(?:table\[\d+\] = \{|Table = \[\d+\]\w+\{|TABLE: \[\w+; \d+\] = \[)(.*?)[}\]];?\n`)
+
+// emittedTable extracts lookup table constants from generated code
+func emittedTable(t *testing.T, code string) []uint64 {
+	t.Helper()
+	match := tableRe.FindStringSubmatch(code)
+	if match == nil {
+		return nil
+	}
+	var ret []uint64
+	for _, field := range strings.Fields(strings.ReplaceAll(match[1], ",", " ")) {
+		v, err := strconv.ParseUint(strings.TrimPrefix(field, "0x"), 16, 64)
+		if err != nil {
+			t.Fatalf("invalid table constant %q", field)
+		}
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+func TestGenerateTables(t *testing.T) {
+	for name, params := range testParameters() {
+		table := crc.NewTable(params)
+		shift := uint(0)
+		if !params.ReflectIn && params.Width < 8 {
+			shift = 8 - params.Width
+		}
+
+		for _, lang := range []codegen.Language{codegen.C, codegen.Go, codegen.Rust} {
+			var buf bytes.Buffer
+			if err := codegen.Generate(&buf, params, codegen.Options{Language: lang}); err != nil {
+				t.Fatalf("Generate(%s, %v) error = %v", name, lang, err)
+			}
+			got := emittedTable(t, buf.String())
+			want := table.Entries()
+			if len(got) != len(want) {
+				t.Fatalf("Generate(%s, %v) emitted %d table entries, want %d", name, lang, len(got), len(want))
+			}
+			for i := range want {
+				if got[i] != want[i]<<shift {
+					t.Errorf("Generate(%s, %v) table[%d] = 0x%X, want 0x%X", name, lang, i, got[i], want[i]<<shift)
+				}
+			}
+
+			buf.Reset()
+			if err := codegen.Generate(&buf, params, codegen.Options{Language: lang, Variant: codegen.NibbleTable}); err != nil {
+				t.Fatalf("Generate(%s, %v) error = %v", name, lang, err)
+			}
+			m, _ := codegen.NewModel(params, codegen.NibbleTable)
+			if got := emittedTable(t, buf.String()); len(got) != 16 || !equal(got, m.Table) {
+				t.Errorf("Generate(%s, %v) nibble table = %X, want %X", name, lang, got, m.Table)
+			}
+
+			buf.Reset()
+			if err := codegen.Generate(&buf, params, codegen.Options{Language: lang, Variant: codegen.Bitwise}); err != nil {
+				t.Fatalf("Generate(%s, %v) error = %v", name, lang, err)
+			}
+			if got := emittedTable(t, buf.String()); got != nil {
+				t.Errorf("Generate(%s, %v) bitwise variant emitted table", name, lang)
+			}
+		}
+	}
+}
+
+func equal(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGenerateNames(t *testing.T) {
+	tests := []struct {
+		lang codegen.Language
+		opts codegen.Options
+		want []string
+	}{
+		{codegen.C, codegen.Options{}, []string{"uint16_t crc16modbus(const void *data, size_t len)", "static inline uint16_t crc16modbus_init(void)", "returns 0x4B37"}},
+		{codegen.Go, codegen.Options{Package: "modbus"}, []string{"package modbus\n", "func CRC16MODBUS(data []byte) uint16 {", "crc16modbusTable"}},
+		{codegen.Go, codegen.Options{Name: "Checksum"}, []string{"package crc\n", "func ChecksumUpdate(crc uint16, data []byte) uint16 {"}},
+		{codegen.Rust, codegen.Options{Name: "modbus"}, []string{"pub fn modbus(data: &[u8]) -> u16 {", "const MODBUS_TABLE: [u16; 256]", "0x4B37);"}},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		tt.opts.Language = tt.lang
+		if err := codegen.Generate(&buf, crc.CRC16MODBUS, tt.opts); err != nil {
+			t.Fatalf("Generate(%v) error = %v", tt.lang, err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(buf.String(), want) {
+				t.Errorf("Generate(%v, %+v) does not contain %q", tt.lang, tt.opts, want)
+			}
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	var buf bytes.Buffer
+	if err := codegen.Generate(&buf, &crc.Parameters{Width: 65, Polynomial: 1}, codegen.Options{}); err == nil {
+		t.Errorf("Generate() accepted width 65")
+	}
+	if err := codegen.Generate(&buf, crc.CRC32, codegen.Options{Language: codegen.Language(42)}); err == nil {
+		t.Errorf("Generate() accepted unknown language")
+	}
+	if err := codegen.Generate(&buf, crc.CRC32, codegen.Options{Variant: codegen.Variant(42)}); err == nil {
+		t.Errorf("Generate() accepted unknown variant")
+	}
+}
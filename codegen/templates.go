@@ -0,0 +1,276 @@
+package codegen
+
+import (
+	"strings"
+	"text/template"
+)
+
+var funcs = template.FuncMap{
+	"sub":   func(a, b uint) uint { return a - b },
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+}
+
+var cTemplate = template.Must(template.New("c").Funcs(funcs).Parse(`/*
+ * {{.Name}} CRC implementation generated by github.com/ast-dd/crc/codegen.
+ * {{.Params}}
+ * Variant: {{.Variant}}
+ *
+ * This file is self-contained and can be included as a header.
+ * {{.Name}}("123456789", 9) returns {{.Check}}.
+ */
+#include <stddef.h>
+#include <stdint.h>
+{{- if .Table}}
+
+static const {{.Type}} {{.Name}}_table[{{len .Table}}] = {
+{{- range .Rows}}
+    {{.}}
+{{- end}}
+};
+{{- end}}
+
+/* {{.Name}}_init returns the initial CRC register value. */
+static inline {{.Type}} {{.Name}}_init(void)
+{
+    return {{.Hex .Init}};
+}
+
+/* {{.Name}}_update processes len bytes of data and returns the updated CRC register. */
+static inline {{.Type}} {{.Name}}_update({{.Type}} crc, const void *data, size_t len)
+{
+    const uint8_t *p = (const uint8_t *)data;
+{{- if eq .Variant 2}}
+    int i;
+{{- end}}
+
+    while (len--) {
+{{- if eq .Variant 0}}
+{{- if .Reflected}}
+        crc = {{.Name}}_table[(crc ^ *p++) & 0xFF]{{if gt .RegWidth 8}} ^ (crc >> 8){{end}};
+{{- else if gt .RegWidth 8}}
+        crc = ({{.Name}}_table[((crc >> {{sub .RegWidth 8}}) ^ *p++) & 0xFF] ^ (crc << 8)) & {{.Hex .Mask}};
+{{- else}}
+        crc = {{.Name}}_table[crc ^ *p++];
+{{- end}}
+{{- else if eq .Variant 1}}
+{{- if .Reflected}}
+        crc = {{.Name}}_table[(crc ^ *p) & 0x0F] ^ (crc >> 4);
+        crc = {{.Name}}_table[(crc ^ (*p++ >> 4)) & 0x0F] ^ (crc >> 4);
+{{- else}}
+        crc = ({{.Name}}_table[((crc >> {{sub .RegWidth 4}}) ^ (*p >> 4)) & 0x0F] ^ (crc << 4)) & {{.Hex .Mask}};
+        crc = ({{.Name}}_table[((crc >> {{sub .RegWidth 4}}) ^ *p++) & 0x0F] ^ (crc << 4)) & {{.Hex .Mask}};
+{{- end}}
+{{- else}}
+{{- if .Reflected}}
+        crc ^= *p++;
+        for (i = 0; i < 8; i++)
+            crc = (crc & 1) ? (crc >> 1) ^ {{.Hex .Poly}} : (crc >> 1);
+{{- else}}
+        crc ^= ({{.Type}})*p++{{if gt .RegWidth 8}} << {{sub .RegWidth 8}}{{end}};
+        for (i = 0; i < 8; i++)
+            crc = (crc & {{.Hex .Top}}) ? (crc << 1) ^ {{.Hex .Poly}} : (crc << 1);
+        crc &= {{.Hex .Mask}};
+{{- end}}
+{{- end}}
+    }
+    return crc;
+}
+
+/* {{.Name}}_final returns the CRC value for the CRC register. */
+static inline {{.Type}} {{.Name}}_final({{.Type}} crc)
+{
+{{- if .Shift}}
+    crc >>= {{.Shift}};
+{{- end}}
+{{- if .ReflectOut}}
+    {{.Type}} r = 0;
+    int i;
+
+    for (i = 0; i < {{.Width}}; i++) {
+        r = (r << 1) | (crc & 1);
+        crc >>= 1;
+    }
+    crc = r;
+{{- end}}
+    return (crc ^ {{.Hex .XorOut}}) & {{.Hex .WidthMask}};
+}
+
+/* {{.Name}} calculates CRC of len bytes of data in one call. */
+static inline {{.Type}} {{.Name}}(const void *data, size_t len)
+{
+    return {{.Name}}_final({{.Name}}_update({{.Name}}_init(), data, len));
+}
+`))
+
+var goTemplate = template.Must(template.New("go").Funcs(funcs).Parse(`// Code generated by github.com/ast-dd/crc/codegen. DO NOT EDIT.
+
+// {{.Name}} CRC implementation.
+// {{.Params}}
+// Variant: {{.Variant}}
+package {{.Package}}
+{{- if .Table}}
+
+var {{lower .Name}}Table = [{{len .Table}}]{{.Type}}{
+{{- range .Rows}}
+	{{.}}
+{{- end}}
+}
+{{- end}}
+
+// {{.Name}}Init returns the initial CRC register value.
+func {{.Name}}Init() {{.Type}} {
+	return {{.Hex .Init}}
+}
+
+// {{.Name}}Update processes data and returns the updated CRC register.
+func {{.Name}}Update(crc {{.Type}}, data []byte) {{.Type}} {
+	for _, b := range data {
+{{- if eq .Variant 0}}
+{{- if .Reflected}}
+		crc = {{lower .Name}}Table[byte(crc)^b]{{if gt .RegWidth 8}} ^ (crc >> 8){{end}}
+{{- else if gt .RegWidth 8}}
+		crc = ({{lower .Name}}Table[byte(crc>>{{sub .RegWidth 8}})^b] ^ (crc << 8)) & {{.Hex .Mask}}
+{{- else}}
+		crc = {{lower .Name}}Table[crc^b]
+{{- end}}
+{{- else if eq .Variant 1}}
+{{- if .Reflected}}
+		crc = {{lower .Name}}Table[(crc^{{.Type}}(b))&0x0F] ^ (crc >> 4)
+		crc = {{lower .Name}}Table[(crc^{{.Type}}(b>>4))&0x0F] ^ (crc >> 4)
+{{- else}}
+		crc = ({{lower .Name}}Table[((crc>>{{sub .RegWidth 4}})^{{.Type}}(b>>4))&0x0F] ^ (crc << 4)) & {{.Hex .Mask}}
+		crc = ({{lower .Name}}Table[((crc>>{{sub .RegWidth 4}})^{{.Type}}(b))&0x0F] ^ (crc << 4)) & {{.Hex .Mask}}
+{{- end}}
+{{- else}}
+{{- if .Reflected}}
+		crc ^= {{.Type}}(b)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = (crc >> 1) ^ {{.Hex .Poly}}
+			} else {
+				crc >>= 1
+			}
+		}
+{{- else}}
+		crc ^= {{.Type}}(b){{if gt .RegWidth 8}} << {{sub .RegWidth 8}}{{end}}
+		for i := 0; i < 8; i++ {
+			if crc&{{.Hex .Top}} != 0 {
+				crc = (crc << 1) ^ {{.Hex .Poly}}
+			} else {
+				crc <<= 1
+			}
+		}
+		crc &= {{.Hex .Mask}}
+{{- end}}
+{{- end}}
+	}
+	return crc
+}
+
+// {{.Name}}Final returns the CRC value for the CRC register.
+func {{.Name}}Final(crc {{.Type}}) {{.Type}} {
+{{- if .Shift}}
+	crc >>= {{.Shift}}
+{{- end}}
+{{- if .ReflectOut}}
+	var r {{.Type}}
+	for i := 0; i < {{.Width}}; i++ {
+		r = (r << 1) | (crc & 1)
+		crc >>= 1
+	}
+	crc = r
+{{- end}}
+	return (crc ^ {{.Hex .XorOut}}) & {{.Hex .WidthMask}}
+}
+
+// {{.Name}} calculates CRC of data in one call.
+// {{.Name}}([]byte("123456789")) returns {{.Check}}.
+func {{.Name}}(data []byte) {{.Type}} {
+	return {{.Name}}Final({{.Name}}Update({{.Name}}Init(), data))
+}
+`))
+
+var rustTemplate = template.Must(template.New("rust").Funcs(funcs).Parse(`//! {{.Name}} CRC implementation generated by github.com/ast-dd/crc/codegen.
+//! {{.Params}}
+//! Variant: {{.Variant}}
+{{- if .Table}}
+
+const {{upper .Name}}_TABLE: [{{.Type}}; {{len .Table}}] = [
+{{- range .Rows}}
+    {{.}}
+{{- end}}
+];
+{{- end}}
+
+/// Returns the initial CRC register value.
+pub fn {{.Name}}_init() -> {{.Type}} {
+    {{.Hex .Init}}
+}
+
+/// Processes data and returns the updated CRC register.
+pub fn {{.Name}}_update(mut crc: {{.Type}}, data: &[u8]) -> {{.Type}} {
+    for &b in data {
+{{- if eq .Variant 0}}
+{{- if .Reflected}}
+        crc = {{upper .Name}}_TABLE[((crc ^ b as {{.Type}}) & 0xFF) as usize]{{if gt .RegWidth 8}} ^ (crc >> 8){{end}};
+{{- else if gt .RegWidth 8}}
+        crc = ({{upper .Name}}_TABLE[(((crc >> {{sub .RegWidth 8}}) ^ b as {{.Type}}) & 0xFF) as usize] ^ (crc << 8)) & {{.Hex .Mask}};
+{{- else}}
+        crc = {{upper .Name}}_TABLE[(crc ^ b) as usize];
+{{- end}}
+{{- else if eq .Variant 1}}
+{{- if .Reflected}}
+        crc = {{upper .Name}}_TABLE[((crc ^ b as {{.Type}}) & 0x0F) as usize] ^ (crc >> 4);
+        crc = {{upper .Name}}_TABLE[((crc ^ (b >> 4) as {{.Type}}) & 0x0F) as usize] ^ (crc >> 4);
+{{- else}}
+        crc = ({{upper .Name}}_TABLE[(((crc >> {{sub .RegWidth 4}}) ^ (b >> 4) as {{.Type}}) & 0x0F) as usize] ^ (crc << 4)) & {{.Hex .Mask}};
+        crc = ({{upper .Name}}_TABLE[(((crc >> {{sub .RegWidth 4}}) ^ b as {{.Type}}) & 0x0F) as usize] ^ (crc << 4)) & {{.Hex .Mask}};
+{{- end}}
+{{- else}}
+{{- if .Reflected}}
+        crc ^= b as {{.Type}};
+        for _ in 0..8 {
+            crc = if crc & 1 != 0 { (crc >> 1) ^ {{.Hex .Poly}} } else { crc >> 1 };
+        }
+{{- else}}
+        crc ^= {{if gt .RegWidth 8}}(b as {{.Type}}) << {{sub .RegWidth 8}}{{else}}b as {{.Type}}{{end}};
+        for _ in 0..8 {
+            crc = if crc & {{.Hex .Top}} != 0 { (crc << 1) ^ {{.Hex .Poly}} } else { crc << 1 };
+        }
+        crc &= {{.Hex .Mask}};
+{{- end}}
+{{- end}}
+    }
+    crc
+}
+
+/// Returns the CRC value for the CRC register.
+pub fn {{.Name}}_final({{if or .Shift .ReflectOut}}mut {{end}}crc: {{.Type}}) -> {{.Type}} {
+{{- if .Shift}}
+    crc >>= {{.Shift}};
+{{- end}}
+{{- if .ReflectOut}}
+    let mut r: {{.Type}} = 0;
+    for _ in 0..{{.Width}} {
+        r = (r << 1) | (crc & 1);
+        crc >>= 1;
+    }
+    crc = r;
+{{- end}}
+    (crc ^ {{.Hex .XorOut}}) & {{.Hex .WidthMask}}
+}
+
+/// Calculates CRC of data in one call.
+pub fn {{.Name}}(data: &[u8]) -> {{.Type}} {
+    {{.Name}}_final({{.Name}}_update({{.Name}}_init(), data))
+}
+
+#[cfg(test)]
+mod tests {
+    #[test]
+    fn check() {
+        assert_eq!(super::{{.Name}}(b"123456789"), {{.Check}});
+    }
+}
+`))
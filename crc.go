@@ -11,6 +11,10 @@
 // A good list of parameter sets for various CRC algorithms can be found at http://reveng.sourceforge.net/crc-catalogue/.
 package crc
 
+import "sync"
+
+//go:generate go run gen_tables.go
+
 // Parameters represents set of parameters defining a particular CRC algorithm.
 type Parameters struct {
 	Width      uint   // Width of the CRC expressed in bits
@@ -134,6 +138,19 @@ var (
 	// CRC-16/PROFIBUS
 	CRC16PROFIBUS = &Parameters{Width: 16, Polynomial: 0x1DCF, Init: 0xFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFFF}
 
+	// CRC-15/CAN, CRC-15
+	CRC15CAN = &Parameters{Width: 15, Polynomial: 0x4599, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000}
+	// CRC-17/CAN-FD
+	CRC17CANFD = &Parameters{Width: 17, Polynomial: 0x1685B, Init: 0x00000, ReflectIn: false, ReflectOut: false, FinalXor: 0x00000}
+	// CRC-21/CAN-FD
+	CRC21CANFD = &Parameters{Width: 21, Polynomial: 0x102899, Init: 0x000000, ReflectIn: false, ReflectOut: false, FinalXor: 0x000000}
+	// CRC-24/BLE
+	CRC24BLE = &Parameters{Width: 24, Polynomial: 0x00065B, Init: 0x555555, ReflectIn: true, ReflectOut: true, FinalXor: 0x000000}
+	// CRC-5/USB
+	CRC5USB = &Parameters{Width: 5, Polynomial: 0x05, Init: 0x1F, ReflectIn: true, ReflectOut: true, FinalXor: 0x1F}
+	// CRC-7/MMC, CRC-7
+	CRC7MMC = &Parameters{Width: 7, Polynomial: 0x09, Init: 0x00, ReflectIn: false, ReflectOut: false, FinalXor: 0x00}
+
 	// CRC32 is by far the the most commonly used CRC-32 polynom and set of parameters
 	// CRC-32, CRC-32/ISO-HDLC, CRC-32/ADCCP, CRC-32/V-42, CRC-32/XZ, PKZIP
 	CRC32 = &Parameters{Width: 32, Polynomial: 0x04C11DB7, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFF}
@@ -232,6 +249,13 @@ func CalculateCRC(crcParams *Parameters, data []byte) uint64 {
 	return curValue & mask
 }
 
+// tableKey identifies a lookup table, which only depends on these parameters
+type tableKey struct {
+	width      uint
+	polynomial uint64
+	reflectIn  bool
+}
+
 // Table represents the partial evaluation of a checksum using table-driven
 // implementation. It is essentially immutable once initialized and thread safe as a result.
 type Table struct {
@@ -239,18 +263,30 @@ type Table struct {
 	crctable  []uint64
 	mask      uint64
 	initValue uint64
+	engine    Engine
+	poly      uint64 // polynomial in register form, used for bitwise updates
+
+	shiftsOnce sync.Once
+	shifts     []gf2Matrix // shifts[k] processes 2^k zero bytes, built on first use
 }
 
 // NewTable creates and initializes a new Table for the CRC algorithm specified by the crcParams.
 func NewTable(crcParams *Parameters) *Table {
 	ret := &Table{crcParams: *crcParams}
 	ret.mask = (uint64(1) << crcParams.Width) - 1
-	ret.crctable = make([]uint64, 256, 256)
-	ret.initValue = crcParams.Init
+	ret.initValue = ret.InitCrcWith(crcParams.Init)
+	ret.poly = crcParams.Polynomial & ret.mask
 	if crcParams.ReflectIn {
-		ret.initValue = reflect(crcParams.Init, crcParams.Width)
+		ret.poly = reflect(ret.poly, crcParams.Width)
 	}
 
+	// tables of known CRC algorithms are precomputed by gen_tables.go
+	if table, ok := staticTables[tableKey{crcParams.Width, crcParams.Polynomial, crcParams.ReflectIn}]; ok {
+		ret.crctable = table[:]
+		return ret
+	}
+
+	ret.crctable = make([]uint64, 256, 256)
 	tmp := make([]byte, 1, 1)
 	tableParams := *crcParams
 	tableParams.Init = 0
@@ -268,9 +304,28 @@ func (t *Table) InitCrc() uint64 {
 	return t.initValue
 }
 
+// InitCrcWith returns a starting value for a new CRC calculation using init in place of Parameters.Init.
+// It allows algorithms with per-session initial value, like Bluetooth LE connections, to share a Table.
+func (t *Table) InitCrcWith(init uint64) uint64 {
+	init &= (uint64(1) << t.crcParams.Width) - 1
+	if t.crcParams.ReflectIn {
+		return reflect(init, t.crcParams.Width)
+	}
+	return init
+}
+
 // UpdateCrc process supplied bytes and updates current (partial) CRC accordingly.
 // It can be called repetitively to process larger data in chunks.
 func (t *Table) UpdateCrc(curValue uint64, p []byte) uint64 {
+	switch t.engine {
+	case NibbleTableEngine:
+		return t.updateNibble(curValue, p)
+	case SplitNibbleTableEngine:
+		return t.updateSplitNibble(curValue, p)
+	case BitwiseEngine:
+		return t.updateBitwise(curValue, p)
+	}
+
 	if t.crcParams.ReflectIn {
 		for _, v := range p {
 			curValue = t.crctable[(byte(curValue)^v)&0xFF] ^ (curValue >> 8)
@@ -287,6 +342,30 @@ func (t *Table) UpdateCrc(curValue uint64, p []byte) uint64 {
 	return curValue
 }
 
+// UpdateBits processes lowest count bits of v, at most 64, and updates current (partial) CRC accordingly.
+// Bits are taken starting with the least significant one for algorithms with reflected input
+// and with the most significant one otherwise, so that whole bytes are processed the same way as by UpdateCrc.
+// It allows calculating CRC over bit streams, which are not made of whole bytes.
+func (t *Table) UpdateBits(curValue uint64, v uint64, count uint) uint64 {
+	var b [1]byte
+	if t.crcParams.ReflectIn {
+		for ; count >= 8; count -= 8 {
+			b[0] = byte(v)
+			curValue = t.UpdateCrc(curValue, b[:])
+			v >>= 8
+		}
+	} else {
+		for ; count >= 8; count -= 8 {
+			b[0] = byte(v >> (count - 8))
+			curValue = t.UpdateCrc(curValue, b[:])
+		}
+	}
+	if count == 0 {
+		return curValue
+	}
+	return t.updateBits(curValue, v&(uint64(1)<<count-1), count)
+}
+
 // CRC returns CRC value for the data processed so far.
 func (t *Table) CRC(curValue uint64) uint64 {
 	ret := curValue
@@ -322,6 +401,20 @@ func (t *Table) CalculateCRC(data []byte) uint64 {
 	return t.CRC(crc)
 }
 
+// Entries returns a copy of the lookup table used by this Table.
+// For ByteTableEngine, entry i is the CRC register after processing byte i starting from zero register.
+// Number and meaning of entries for other engines is described at their constants.
+func (t *Table) Entries() []uint64 {
+	ret := make([]uint64, len(t.crctable))
+	copy(ret, t.crctable)
+	return ret
+}
+
+// Parameters returns a copy of parameters this Table was created for.
+func (t *Table) Parameters() Parameters {
+	return t.crcParams
+}
+
 // Hash represents the partial evaluation of a checksum using table-driven
 // implementation. It also implements hash.Hash interface.
 type Hash struct {
@@ -147,6 +147,13 @@ func TestCRCAlgorithms(t *testing.T) {
 		{algo: crc.CRC32Q, crc: [4]uint64{0x3010BF7F, 0x59F2D11A, 0xDBE0EEB1, 0x4ED076AE}},
 		{algo: crc.Koopman, crc: [4]uint64{0x2D3DD0AE, 0xCC53DEAC, 0x1B8101F9, 0xA41634B2}},
 
+		{algo: crc.CRC15CAN, crc: [4]uint64{0x059E, 0, 0, 0}},
+		{algo: crc.CRC17CANFD, crc: [4]uint64{0x04F03, 0, 0, 0}},
+		{algo: crc.CRC21CANFD, crc: [4]uint64{0x0ED841, 0, 0, 0}},
+		{algo: crc.CRC24BLE, crc: [4]uint64{0xC25A56, 0, 0, 0}},
+		{algo: crc.CRC5USB, crc: [4]uint64{0x19, 0, 0, 0}},
+		{algo: crc.CRC7MMC, crc: [4]uint64{0x75, 0, 0, 0}},
+
 		{algo: crc.CRC32AUTOSAR, crc: [4]uint64{0x1697d06a, 0, 0, 0}},
 		{algo: crc.CRC32CDROMEDC, crc: [4]uint64{0x6ec2edc4, 0, 0, 0}},
 		{algo: crc.CRC32MEF, crc: [4]uint64{0xd2c22f51, 0, 0, 0}},
@@ -246,6 +253,24 @@ func TestSizeMethods(t *testing.T) {
 
 }
 
+func TestInitCrcWith(t *testing.T) {
+	data := []byte(byteTestStrings[2])
+	for _, name := range crc.ParametersNames() {
+		p, _ := crc.GetParameters(name)
+		table := crc.NewTable(p)
+		if got, want := table.InitCrcWith(p.Init), table.InitCrc(); got != want {
+			t.Errorf("%s InitCrcWith(Init) = 0x%X, want 0x%X", name, got, want)
+		}
+
+		custom := *p
+		custom.Init = 0x5A5A5A5A5A5A5A5A & (uint64(1)<<p.Width - 1)
+		got := table.CRC(table.UpdateCrc(table.InitCrcWith(custom.Init), data))
+		if want := crc.CalculateCRC(&custom, data); got != want {
+			t.Errorf("%s CRC with InitCrcWith(0x%X) = 0x%X, want 0x%X", name, custom.Init, got, want)
+		}
+	}
+}
+
 func TestHashInterface(t *testing.T) {
 	doTest := func(crcParams *crc.Parameters, data string, want uint64) {
 		// same test using table driven
@@ -289,6 +314,42 @@ func TestHashInterface(t *testing.T) {
 	doTest(crc.CRC32C, "Whenever digital data is stored or interfaced, data corruption might occur. Since the beginning of computer science, people have been thinking of ways to deal with this type of problem. For serial data they came up with the solution to attach a parity bit to each sent byte. This simple detection mechanism works if an odd number of bits in a byte changes, but an even number of false bits in one byte will not be detected by the parity check. To overcome this problem people have searched for mathematical sound mechanisms to detect multiple false bits.", 0x864FDAFC)
 }
 
+func TestStaticTables(t *testing.T) {
+	for _, name := range crc.ParametersNames() {
+		params, _ := crc.GetParameters(name)
+		tableParams := *params
+		tableParams.Init = 0
+		tableParams.ReflectOut = tableParams.ReflectIn
+		tableParams.FinalXor = 0
+
+		entries := crc.NewTable(params).Entries()
+		for i, got := range entries {
+			if want := crc.CalculateCRC(&tableParams, []byte{byte(i)}); got != want {
+				t.Errorf("%s table entry %d = 0x%X, want 0x%X (run go generate)", name, i, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkNewTable(b *testing.B) {
+	custom := &crc.Parameters{Width: 16, Polynomial: 0x1021, Init: 0x1234, ReflectIn: true, ReflectOut: false}
+	b.Run("static", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			crc.NewTable(crc.CRC16MODBUS)
+		}
+	})
+	b.Run("computed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			crc.NewTable(&crc.Parameters{Width: 16, Polynomial: 0x8BB6, Init: 0xFFFF})
+		}
+	})
+	b.Run("custom with static", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			crc.NewTable(custom)
+		}
+	})
+}
+
 func BenchmarkCCITT(b *testing.B) {
 	data := []byte("Whenever digital data is stored or interfaced, data corruption might occur. Since the beginning of computer science, people have been thinking of ways to deal with this type of problem. For serial data they came up with the solution to attach a parity bit to each sent byte. This simple detection mechanism works if an odd number of bits in a byte changes, but an even number of false bits in one byte will not be detected by the parity check. To overcome this problem people have searched for mathematical sound mechanisms to detect multiple false bits.")
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,174 @@
+// Package e2e implements AUTOSAR end-to-end (E2E) communication protection profiles.
+//
+// A Profile defines the layout of the E2E header within protected data and how
+// its CRC is calculated. Protector writes headers with an incrementing counter into
+// outgoing data, Checker verifies incoming data and classifies it by the counter
+// relative to the previously received one.
+//
+// Supported profiles and their CRCs are:
+//
+//	P01, P11  CRC-8/SAE-J1850 polynomial with start value and final xor 0x00
+//	P02, P22  CRC-8/AUTOSAR (crc.CRC8AUTOSAR)
+//	P04       CRC-32/AUTOSAR (crc.CRC32AUTOSAR)
+//	P05, P06  CRC-16/CCITT-FALSE (crc.CRC16CCITTFALSE)
+//	P07       CRC-64/XZ (crc.CRC64ECMA)
+//
+// Unlike the AUTOSAR specification, lengths and offsets of the byte oriented profiles
+// are given in bytes. Offsets of the nibble oriented profiles P01 and P11 are in bits.
+package e2e
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ast-dd/crc"
+)
+
+// Status is the result of Checker.Check.
+type Status int
+
+const (
+	// StatusOK means that data is valid and the counter incremented by at most the maximal delta.
+	StatusOK Status = iota
+	// StatusRepeated means that data is valid, but has the same counter as previous data.
+	StatusRepeated
+	// StatusWrongSequence means that data is valid, but the counter jumped by more than the maximal delta.
+	StatusWrongSequence
+	// StatusError means that data is corrupted: the header does not match the data or configuration.
+	StatusError
+	// StatusNoNewData means that no data was received.
+	StatusNoNewData
+)
+
+// String returns the name of the status as used by AUTOSAR
+func (s Status) String() string {
+	switch s {
+	case StatusOK:
+		return "OK"
+	case StatusRepeated:
+		return "REPEATED"
+	case StatusWrongSequence:
+		return "WRONGSEQUENCE"
+	case StatusError:
+		return "ERROR"
+	case StatusNoNewData:
+		return "NONEWDATA"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+var (
+	// ErrLength is returned for data of invalid length or with length field not matching it.
+	ErrLength = errors.New("e2e: invalid data length")
+	// ErrCRC is returned for data with invalid CRC.
+	ErrCRC = errors.New("e2e: CRC mismatch")
+	// ErrDataID is returned for data with explicitly transmitted DataID not matching the configured one.
+	ErrDataID = errors.New("e2e: DataID mismatch")
+	// ErrCounter is returned for data with counter outside of the valid range.
+	ErrCounter = errors.New("e2e: invalid counter")
+)
+
+// Profile defines the E2E header layout and CRC calculation.
+type Profile interface {
+	// Protect writes the header with given counter into data.
+	Protect(data []byte, counter uint32) error
+	// Verify checks the header of data and returns its counter.
+	Verify(data []byte) (counter uint32, err error)
+	// MaxCounter returns the maximal counter value, after which the counter wraps to zero.
+	MaxCounter() uint32
+}
+
+// Protector protects outgoing data incrementing the counter each time.
+type Protector struct {
+	profile Profile
+	counter uint32
+}
+
+// NewProtector creates a new Protector starting with counter 0.
+func NewProtector(profile Profile) *Protector {
+	return &Protector{profile: profile}
+}
+
+// Protect writes the E2E header into data. The counter is incremented only if it succeeds.
+func (p *Protector) Protect(data []byte) error {
+	if err := p.profile.Protect(data, p.counter); err != nil {
+		return err
+	}
+	if p.counter == p.profile.MaxCounter() {
+		p.counter = 0
+	} else {
+		p.counter++
+	}
+	return nil
+}
+
+// Counter returns the counter used by the next call to Protect.
+func (p *Protector) Counter() uint32 {
+	return p.counter
+}
+
+// Checker verifies incoming data and tracks the counter.
+// Before the first data is received, the previous counter is assumed to be MaxCounter(),
+// so that data protected by a fresh Protector is OK.
+type Checker struct {
+	profile  Profile
+	maxDelta uint32
+	last     uint32
+}
+
+// NewChecker creates a new Checker accepting counter increments up to maxDeltaCounter as OK.
+func NewChecker(profile Profile, maxDeltaCounter uint32) *Checker {
+	return &Checker{profile: profile, maxDelta: maxDeltaCounter, last: profile.MaxCounter()}
+}
+
+// Check verifies data, nil meaning that no data was received.
+func (c *Checker) Check(data []byte) Status {
+	if data == nil {
+		return StatusNoNewData
+	}
+	counter, err := c.profile.Verify(data)
+	if err != nil {
+		return StatusError
+	}
+
+	delta := (uint64(counter) + uint64(c.profile.MaxCounter()) + 1 - uint64(c.last)) % (uint64(c.profile.MaxCounter()) + 1)
+	c.last = counter
+	switch {
+	case delta == 0:
+		return StatusRepeated
+	case delta <= uint64(c.maxDelta):
+		return StatusOK
+	}
+	return StatusWrongSequence
+}
+
+// crcExcluding returns CRC register after processing data except bytes between start and end
+func crcExcluding(table *crc.Table, data []byte, start, end int) uint64 {
+	return table.UpdateCrc(table.UpdateCrc(table.InitCrc(), data[:start]), data[end:])
+}
+
+// checkLength validates that length is between min and max
+func checkLength(length, min, max int) error {
+	if length < min || length > max {
+		return ErrLength
+	}
+	return nil
+}
+
+// newLengthLimits validates header offset and minimal and maximal data length configured, replacing zero maximum by limit
+func newLengthLimits(minLength, maxLength, offset, headerSize, limit int) (int, int, error) {
+	if offset < 0 {
+		return 0, 0, fmt.Errorf("e2e: invalid offset %d", offset)
+	}
+	header := offset + headerSize
+	if maxLength == 0 {
+		maxLength = limit
+	}
+	if minLength < header {
+		minLength = header
+	}
+	if minLength > maxLength || maxLength > limit {
+		return 0, 0, fmt.Errorf("e2e: invalid data length limits %d-%d, must be within %d-%d", minLength, maxLength, header, limit)
+	}
+	return minLength, maxLength, nil
+}
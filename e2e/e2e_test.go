@@ -0,0 +1,206 @@
+package e2e_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/ast-dd/crc"
+	"github.com/ast-dd/crc/e2e"
+)
+
+var dataIDList = [16]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}
+
+type testProfile struct {
+	profile e2e.Profile
+	length  int // length of protected data
+}
+
+func testProfiles(t *testing.T) map[string]testProfile {
+	p01 := e2e.P01Config{DataID: 0x123, Length: 8, CRCOffset: 0, CounterOffset: 8, DataIDNibbleOffset: 12}
+	p01Nibble := p01
+	p01Nibble.DataIDMode = e2e.DataIDNibble
+	p01Alt := p01
+	p01Alt.DataIDMode = e2e.DataIDAlt
+	p01Alt.CRCOffset, p01Alt.CounterOffset = 56, 4
+
+	profiles := map[string]testProfile{}
+	add := func(name string, length int) func(e2e.Profile, error) {
+		return func(p e2e.Profile, err error) {
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			profiles[name] = testProfile{p, length}
+		}
+	}
+	add("P01", 8)(e2e.NewP01(p01))
+	add("P01 nibble", 8)(e2e.NewP01(p01Nibble))
+	add("P01 alt", 8)(e2e.NewP01(p01Alt))
+	add("P02", 8)(e2e.NewP02(e2e.P02Config{DataIDList: dataIDList, Length: 8}))
+	add("P04", 30)(e2e.NewP04(e2e.P04Config{DataID: 0x0A0B0C0D, Offset: 2, MinLength: 20}))
+	add("P05", 10)(e2e.NewP05(e2e.P05Config{DataID: 0x1234, Offset: 1, Length: 10}))
+	add("P06", 12)(e2e.NewP06(e2e.P06Config{DataID: 0x1234, Offset: 1}))
+	add("P07", 40)(e2e.NewP07(e2e.P07Config{DataID: 0x0A0B0C0D}))
+	add("P11", 8)(e2e.NewP11(p01Nibble))
+	add("P22", 16)(e2e.NewP22(e2e.P22Config{DataIDList: dataIDList, Length: 16, Offset: 4}))
+	return profiles
+}
+
+func TestProtectCheck(t *testing.T) {
+	for name, tt := range testProfiles(t) {
+		protector := e2e.NewProtector(tt.profile)
+		checker := e2e.NewChecker(tt.profile, 2)
+		frame := func() []byte {
+			data := make([]byte, tt.length)
+			for i := range data {
+				data[i] = byte(0x40 + i)
+			}
+			if err := protector.Protect(data); err != nil {
+				t.Fatalf("%s: Protect() error = %v", name, err)
+			}
+			return data
+		}
+		check := func(data []byte, want e2e.Status) {
+			t.Helper()
+			if got := checker.Check(data); got != want {
+				t.Errorf("%s: Check() = %v, want %v", name, got, want)
+			}
+		}
+
+		first := frame()
+		check(first, e2e.StatusOK)
+		check(first, e2e.StatusRepeated)
+		check(nil, e2e.StatusNoNewData)
+		check(frame(), e2e.StatusOK)
+		frame()
+		check(frame(), e2e.StatusOK)
+		frame()
+		frame()
+		check(frame(), e2e.StatusWrongSequence)
+		check(frame(), e2e.StatusOK)
+
+		// any corrupted byte is detected
+		data := frame()
+		for i := range data {
+			data[i] ^= 0x11
+			if got := checker.Check(data); got != e2e.StatusError {
+				t.Errorf("%s: Check() with corrupted byte %d = %v", name, i, got)
+			}
+			data[i] ^= 0x11
+		}
+		check(data, e2e.StatusOK)
+
+		// counter wraps around
+		for protector.Counter() != tt.profile.MaxCounter() && tt.profile.MaxCounter() <= 0xFFFF {
+			check(frame(), e2e.StatusOK)
+		}
+		if tt.profile.MaxCounter() <= 0xFFFF {
+			check(frame(), e2e.StatusOK)
+			if protector.Counter() != 0 {
+				t.Errorf("%s: counter %d did not wrap around", name, protector.Counter())
+			}
+			check(frame(), e2e.StatusOK)
+		}
+
+		if _, err := tt.profile.Verify(make([]byte, tt.length-1)); err != e2e.ErrLength {
+			t.Errorf("%s: Verify(short data) error = %v", name, err)
+		}
+	}
+}
+
+func TestLayout(t *testing.T) {
+	profiles := testProfiles(t)
+	protect := func(name string, counter uint32) []byte {
+		tt := profiles[name]
+		data := make([]byte, tt.length)
+		for i := range data {
+			data[i] = byte(0x40 + i)
+		}
+		if err := tt.profile.Protect(data, counter); err != nil {
+			t.Fatalf("%s: Protect() error = %v", name, err)
+		}
+		return data
+	}
+	p01CRC := &crc.Parameters{Width: 8, Polynomial: 0x1D}
+
+	data := protect("P01", 5)
+	if data[1]&0x0F != 5 || data[0] != byte(crc.CalculateCRC(p01CRC, append([]byte{0x23, 0x01}, data[1:]...))) {
+		t.Errorf("P01 layout % X", data)
+	}
+	data = protect("P01 nibble", 5)
+	if data[1] != 0x15 || data[0] != byte(crc.CalculateCRC(p01CRC, append([]byte{0x23, 0x00}, data[1:]...))) {
+		t.Errorf("P01 nibble layout % X", data)
+	}
+	data = protect("P01 alt", 5)
+	if data[0]>>4 != 5 || data[7] != byte(crc.CalculateCRC(p01CRC, append([]byte{0x01}, data[:7]...))) {
+		t.Errorf("P01 alt layout % X", data)
+	}
+
+	data = protect("P02", 7)
+	if data[1]&0x0F != 7 || data[0] != byte(crc.CalculateCRC(crc.CRC8AUTOSAR, append(data[1:], dataIDList[7]))) {
+		t.Errorf("P02 layout % X", data)
+	}
+	data = protect("P22", 7)
+	if data[5]&0x0F != 7 || data[4] != byte(crc.CalculateCRC(crc.CRC8AUTOSAR, append(append(append([]byte{}, data[:4]...), data[5:]...), dataIDList[7]))) {
+		t.Errorf("P22 layout % X", data)
+	}
+
+	data = protect("P04", 0x1234)
+	if binary.BigEndian.Uint16(data[2:]) != 30 || binary.BigEndian.Uint16(data[4:]) != 0x1234 || binary.BigEndian.Uint32(data[6:]) != 0x0A0B0C0D ||
+		binary.BigEndian.Uint32(data[10:]) != uint32(crc.CalculateCRC(crc.CRC32AUTOSAR, append(append([]byte{}, data[:10]...), data[14:]...))) {
+		t.Errorf("P04 layout % X", data)
+	}
+
+	data = protect("P05", 0x56)
+	if data[3] != 0x56 || binary.LittleEndian.Uint16(data[1:]) != uint16(crc.CalculateCRC(crc.CRC16CCITTFALSE, append(append(append([]byte{}, data[:1]...), data[3:]...), 0x34, 0x12))) {
+		t.Errorf("P05 layout % X", data)
+	}
+	data = protect("P06", 0x56)
+	if binary.BigEndian.Uint16(data[3:]) != 12 || data[5] != 0x56 ||
+		binary.BigEndian.Uint16(data[1:]) != uint16(crc.CalculateCRC(crc.CRC16CCITTFALSE, append(append(append([]byte{}, data[:1]...), data[3:]...), 0x12, 0x34))) {
+		t.Errorf("P06 layout % X", data)
+	}
+
+	data = protect("P07", 0x12345678)
+	if binary.BigEndian.Uint32(data[8:]) != 40 || binary.BigEndian.Uint32(data[12:]) != 0x12345678 || binary.BigEndian.Uint32(data[16:]) != 0x0A0B0C0D ||
+		binary.BigEndian.Uint64(data) != crc.CalculateCRC(crc.CRC64ECMA, data[8:]) {
+		t.Errorf("P07 layout % X", data)
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	for name, err := range map[string]error{
+		"P01 length": func() error { _, err := e2e.NewP01(e2e.P01Config{Length: 31, CounterOffset: 8}); return err }(),
+		"P01 CRC offset": func() error {
+			_, err := e2e.NewP01(e2e.P01Config{Length: 8, CRCOffset: 4, CounterOffset: 8})
+			return err
+		}(),
+		"P01 counter": func() error { _, err := e2e.NewP01(e2e.P01Config{Length: 8, CounterOffset: 4}); return err }(),
+		"P01 nibble DataID": func() error {
+			_, err := e2e.NewP01(e2e.P01Config{Length: 8, CounterOffset: 8, DataIDNibbleOffset: 12, DataID: 0x1000, DataIDMode: e2e.DataIDNibble})
+			return err
+		}(),
+		"P11 mode": func() error {
+			_, err := e2e.NewP11(e2e.P01Config{Length: 8, CounterOffset: 8, DataIDMode: e2e.DataIDAlt})
+			return err
+		}(),
+		"P02 length":          func() error { _, err := e2e.NewP02(e2e.P02Config{Length: 1}); return err }(),
+		"P04 max length":      func() error { _, err := e2e.NewP04(e2e.P04Config{MaxLength: 5000}); return err }(),
+		"P04 min length":      func() error { _, err := e2e.NewP04(e2e.P04Config{MinLength: 100, MaxLength: 50}); return err }(),
+		"P04 negative offset": func() error { _, err := e2e.NewP04(e2e.P04Config{Offset: -1}); return err }(),
+		"P05 offset":          func() error { _, err := e2e.NewP05(e2e.P05Config{Offset: 8, Length: 10}); return err }(),
+		"P06 offset":          func() error { _, err := e2e.NewP06(e2e.P06Config{Offset: 4094}); return err }(),
+		"P06 negative offset": func() error { _, err := e2e.NewP06(e2e.P06Config{Offset: -1}); return err }(),
+		"P07 negative offset": func() error { _, err := e2e.NewP07(e2e.P07Config{Offset: -1, MinLength: 20}); return err }(),
+		"P22 offset":          func() error { _, err := e2e.NewP22(e2e.P22Config{Offset: 7, Length: 8}); return err }(),
+	} {
+		if err == nil {
+			t.Errorf("%s: invalid configuration accepted", name)
+		}
+	}
+}
+
+func TestStatusString(t *testing.T) {
+	if e2e.StatusWrongSequence.String() != "WRONGSEQUENCE" || e2e.Status(10).String() != "Status(10)" {
+		t.Errorf("unexpected status names")
+	}
+}
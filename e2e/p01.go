@@ -0,0 +1,163 @@
+package e2e
+
+import (
+	"fmt"
+
+	"github.com/ast-dd/crc"
+)
+
+// DataIDMode selects how the 16 bit DataID of profiles P01 and P11 is included in the CRC.
+type DataIDMode int
+
+const (
+	// DataIDBoth includes both bytes of DataID, low byte first.
+	DataIDBoth DataIDMode = iota
+	// DataIDAlt includes the low byte of DataID for even counters and the high byte for odd ones.
+	DataIDAlt
+	// DataIDLow includes only the low byte of DataID.
+	DataIDLow
+	// DataIDNibble includes the low byte and a zero byte. The low nibble of the high byte
+	// is transmitted explicitly, so DataID must not exceed 0x0FFF.
+	DataIDNibble
+)
+
+// p01CRC is CRC-8/SAE-J1850 as used by P01 and P11: the AUTOSAR CRC library is called
+// with start value 0xFF as if continuing a calculation, which cancels out its initial value and final xor
+var p01CRC = &crc.Parameters{Width: 8, Polynomial: 0x1D}
+
+const p01MaxCounter = 14
+
+// P01Config configures profiles P01 and P11.
+// Common layout (variant 1A) uses CRCOffset 0, CounterOffset 8 and DataIDNibbleOffset 12.
+type P01Config struct {
+	DataID             uint16
+	DataIDMode         DataIDMode
+	Length             int // Length of protected data in bytes, at most 30
+	CRCOffset          int // CRCOffset is the offset of the CRC byte in bits, must be a multiple of 8
+	CounterOffset      int // CounterOffset is the offset of the 4 bit counter in bits, must be a multiple of 4
+	DataIDNibbleOffset int // DataIDNibbleOffset is the offset of 4 bit DataID nibble in bits, used by DataIDNibble mode
+}
+
+type p01 struct {
+	config P01Config
+	table  *crc.Table
+}
+
+// NewP01 creates profile P01 with 4 bit counter 0-14 and 8 bit CRC.
+func NewP01(config P01Config) (Profile, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return &p01{config: config, table: crc.CachedTable(p01CRC)}, nil
+}
+
+// NewP11 creates profile P11, which has the same layout and CRC as P01,
+// but supports only DataIDBoth and DataIDNibble modes.
+func NewP11(config P01Config) (Profile, error) {
+	if config.DataIDMode != DataIDBoth && config.DataIDMode != DataIDNibble {
+		return nil, fmt.Errorf("e2e: DataID mode %d not supported by P11", config.DataIDMode)
+	}
+	return NewP01(config)
+}
+
+func (c *P01Config) validate() error {
+	bits := 8 * c.Length
+	if c.Length < 2 || c.Length > 30 {
+		return fmt.Errorf("e2e: invalid data length %d", c.Length)
+	}
+	if c.CRCOffset%8 != 0 || c.CRCOffset < 0 || c.CRCOffset >= bits {
+		return fmt.Errorf("e2e: invalid CRC offset %d", c.CRCOffset)
+	}
+	if c.CounterOffset%4 != 0 || c.CounterOffset < 0 || c.CounterOffset >= bits || c.CounterOffset/8 == c.CRCOffset/8 {
+		return fmt.Errorf("e2e: invalid counter offset %d", c.CounterOffset)
+	}
+	if c.DataIDMode < DataIDBoth || c.DataIDMode > DataIDNibble {
+		return fmt.Errorf("e2e: invalid DataID mode %d", c.DataIDMode)
+	}
+	if c.DataIDMode == DataIDNibble {
+		if c.DataID > 0x0FFF {
+			return fmt.Errorf("e2e: DataID 0x%X too big for nibble mode", c.DataID)
+		}
+		if c.DataIDNibbleOffset%4 != 0 || c.DataIDNibbleOffset < 0 || c.DataIDNibbleOffset >= bits ||
+			c.DataIDNibbleOffset/8 == c.CRCOffset/8 || c.DataIDNibbleOffset == c.CounterOffset {
+			return fmt.Errorf("e2e: invalid DataID nibble offset %d", c.DataIDNibbleOffset)
+		}
+	}
+	return nil
+}
+
+func (p *p01) MaxCounter() uint32 {
+	return p01MaxCounter
+}
+
+func (p *p01) Protect(data []byte, counter uint32) error {
+	if len(data) != p.config.Length {
+		return ErrLength
+	}
+	if counter > p01MaxCounter {
+		return ErrCounter
+	}
+	putNibble(data, p.config.CounterOffset, byte(counter))
+	if p.config.DataIDMode == DataIDNibble {
+		putNibble(data, p.config.DataIDNibbleOffset, byte(p.config.DataID>>8))
+	}
+	data[p.config.CRCOffset/8] = p.crc(data, counter)
+	return nil
+}
+
+func (p *p01) Verify(data []byte) (uint32, error) {
+	if len(data) != p.config.Length {
+		return 0, ErrLength
+	}
+	counter := uint32(getNibble(data, p.config.CounterOffset))
+	if counter > p01MaxCounter {
+		return 0, ErrCounter
+	}
+	if p.config.DataIDMode == DataIDNibble && getNibble(data, p.config.DataIDNibbleOffset) != byte(p.config.DataID>>8) {
+		return 0, ErrDataID
+	}
+	if data[p.config.CRCOffset/8] != p.crc(data, counter) {
+		return 0, ErrCRC
+	}
+	return counter, nil
+}
+
+// crc calculates CRC over DataID according to the mode followed by data except the CRC byte
+func (p *p01) crc(data []byte, counter uint32) byte {
+	id := [2]byte{byte(p.config.DataID), byte(p.config.DataID >> 8)}
+	var included []byte
+	switch p.config.DataIDMode {
+	case DataIDBoth:
+		included = id[:]
+	case DataIDAlt:
+		included = id[counter%2 : counter%2+1]
+	case DataIDLow:
+		included = id[:1]
+	case DataIDNibble:
+		id[1] = 0
+		included = id[:]
+	}
+	offset := p.config.CRCOffset / 8
+	curValue := p.table.UpdateCrc(p.table.InitCrc(), included)
+	curValue = p.table.UpdateCrc(curValue, data[:offset])
+	curValue = p.table.UpdateCrc(curValue, data[offset+1:])
+	return p.table.CRC8(curValue)
+}
+
+// putNibble stores low 4 bits of v at given bit offset, which is a multiple of 4
+func putNibble(data []byte, offset int, v byte) {
+	i := offset / 8
+	if offset%8 == 0 {
+		data[i] = data[i]&0xF0 | v&0x0F
+	} else {
+		data[i] = data[i]&0x0F | v<<4
+	}
+}
+
+// getNibble is the reverse of putNibble
+func getNibble(data []byte, offset int) byte {
+	if offset%8 == 0 {
+		return data[offset/8] & 0x0F
+	}
+	return data[offset/8] >> 4
+}
@@ -0,0 +1,80 @@
+package e2e
+
+import (
+	"fmt"
+
+	"github.com/ast-dd/crc"
+)
+
+const p02MaxCounter = 15
+
+// P02Config configures profile P02.
+type P02Config struct {
+	DataIDList [16]byte // DataIDList holds DataID included in CRC for each counter value
+	Length     int      // Length of protected data in bytes, at most 256
+}
+
+// P22Config configures profile P22.
+type P22Config struct {
+	DataIDList [16]byte // DataIDList holds DataID included in CRC for each counter value
+	Length     int      // Length of protected data in bytes, at most 4096
+	Offset     int      // Offset of the header in bytes
+}
+
+// p02 implements profiles P02 and P22: CRC byte at offset followed by a byte with the counter in its low nibble,
+// CRC covers data except the CRC byte followed by DataID selected by the counter
+type p02 struct {
+	dataIDList [16]byte
+	length     int
+	offset     int
+	table      *crc.Table
+}
+
+// NewP02 creates profile P02 with CRC in the first byte and 4 bit counter 0-15 in the low nibble of the second one.
+func NewP02(config P02Config) (Profile, error) {
+	if config.Length < 2 || config.Length > 256 {
+		return nil, fmt.Errorf("e2e: invalid data length %d", config.Length)
+	}
+	return &p02{dataIDList: config.DataIDList, length: config.Length, table: crc.CachedTable(crc.CRC8AUTOSAR)}, nil
+}
+
+// NewP22 creates profile P22, which is P02 with the header at configurable offset.
+func NewP22(config P22Config) (Profile, error) {
+	if config.Length < 2 || config.Length > 4096 || config.Offset < 0 || config.Offset+2 > config.Length {
+		return nil, fmt.Errorf("e2e: invalid data length %d or offset %d", config.Length, config.Offset)
+	}
+	return &p02{dataIDList: config.DataIDList, length: config.Length, offset: config.Offset, table: crc.CachedTable(crc.CRC8AUTOSAR)}, nil
+}
+
+func (p *p02) MaxCounter() uint32 {
+	return p02MaxCounter
+}
+
+func (p *p02) Protect(data []byte, counter uint32) error {
+	if len(data) != p.length {
+		return ErrLength
+	}
+	if counter > p02MaxCounter {
+		return ErrCounter
+	}
+	putNibble(data, 8*(p.offset+1), byte(counter))
+	data[p.offset] = p.crc(data, counter)
+	return nil
+}
+
+func (p *p02) Verify(data []byte) (uint32, error) {
+	if len(data) != p.length {
+		return 0, ErrLength
+	}
+	counter := uint32(getNibble(data, 8*(p.offset+1)))
+	if data[p.offset] != p.crc(data, counter) {
+		return 0, ErrCRC
+	}
+	return counter, nil
+}
+
+func (p *p02) crc(data []byte, counter uint32) byte {
+	curValue := crcExcluding(p.table, data, p.offset, p.offset+1)
+	curValue = p.table.UpdateCrc(curValue, p.dataIDList[counter:counter+1])
+	return p.table.CRC8(curValue)
+}
@@ -0,0 +1,143 @@
+package e2e
+
+import (
+	"encoding/binary"
+
+	"github.com/ast-dd/crc"
+)
+
+const (
+	p04HeaderSize = 12
+	p07HeaderSize = 20
+)
+
+// P04Config configures profile P04.
+type P04Config struct {
+	DataID    uint32
+	Offset    int // Offset of the header in bytes
+	MinLength int // MinLength is the minimal length of data in bytes, at least Offset plus header size
+	MaxLength int // MaxLength is the maximal length of data in bytes, at most 4096, default 4096
+}
+
+// P07Config configures profile P07.
+type P07Config struct {
+	DataID    uint32
+	Offset    int // Offset of the header in bytes
+	MinLength int // MinLength is the minimal length of data in bytes, at least Offset plus header size
+	MaxLength int // MaxLength is the maximal length of data in bytes, at most 4 MiB, default 4 MiB
+}
+
+// p04 implements profile P04: big endian header of 16 bit length, 16 bit counter, 32 bit DataID and 32 bit CRC
+type p04 struct {
+	dataID    uint32
+	offset    int
+	minLength int
+	maxLength int
+	table     *crc.Table
+}
+
+// NewP04 creates profile P04 with 16 bit counter and 32 bit CRC. The 12 byte header
+// at given offset holds data length, counter, DataID and CRC in big endian byte order.
+func NewP04(config P04Config) (Profile, error) {
+	minLength, maxLength, err := newLengthLimits(config.MinLength, config.MaxLength, config.Offset, p04HeaderSize, 4096)
+	if err != nil {
+		return nil, err
+	}
+	return &p04{config.DataID, config.Offset, minLength, maxLength, crc.CachedTable(crc.CRC32AUTOSAR)}, nil
+}
+
+func (p *p04) MaxCounter() uint32 {
+	return 0xFFFF
+}
+
+func (p *p04) Protect(data []byte, counter uint32) error {
+	if err := checkLength(len(data), p.minLength, p.maxLength); err != nil {
+		return err
+	}
+	if counter > 0xFFFF {
+		return ErrCounter
+	}
+	header := data[p.offset:]
+	binary.BigEndian.PutUint16(header[0:], uint16(len(data)))
+	binary.BigEndian.PutUint16(header[2:], uint16(counter))
+	binary.BigEndian.PutUint32(header[4:], p.dataID)
+	binary.BigEndian.PutUint32(header[8:], p.crc(data))
+	return nil
+}
+
+func (p *p04) Verify(data []byte) (uint32, error) {
+	if err := checkLength(len(data), p.minLength, p.maxLength); err != nil {
+		return 0, err
+	}
+	header := data[p.offset:]
+	if int(binary.BigEndian.Uint16(header[0:])) != len(data) {
+		return 0, ErrLength
+	}
+	if binary.BigEndian.Uint32(header[8:]) != p.crc(data) {
+		return 0, ErrCRC
+	}
+	if binary.BigEndian.Uint32(header[4:]) != p.dataID {
+		return 0, ErrDataID
+	}
+	return uint32(binary.BigEndian.Uint16(header[2:])), nil
+}
+
+func (p *p04) crc(data []byte) uint32 {
+	return p.table.CRC32(crcExcluding(p.table, data, p.offset+8, p.offset+12))
+}
+
+// p07 implements profile P07: big endian header of 64 bit CRC, 32 bit length, 32 bit counter and 32 bit DataID
+type p07 struct {
+	dataID    uint32
+	offset    int
+	minLength int
+	maxLength int
+	table     *crc.Table
+}
+
+// NewP07 creates profile P07 with 32 bit counter and 64 bit CRC. The 20 byte header
+// at given offset holds CRC, data length, counter and DataID in big endian byte order.
+func NewP07(config P07Config) (Profile, error) {
+	minLength, maxLength, err := newLengthLimits(config.MinLength, config.MaxLength, config.Offset, p07HeaderSize, 4*1024*1024)
+	if err != nil {
+		return nil, err
+	}
+	return &p07{config.DataID, config.Offset, minLength, maxLength, crc.CachedTable(crc.CRC64ECMA)}, nil
+}
+
+func (p *p07) MaxCounter() uint32 {
+	return 0xFFFFFFFF
+}
+
+func (p *p07) Protect(data []byte, counter uint32) error {
+	if err := checkLength(len(data), p.minLength, p.maxLength); err != nil {
+		return err
+	}
+	header := data[p.offset:]
+	binary.BigEndian.PutUint32(header[8:], uint32(len(data)))
+	binary.BigEndian.PutUint32(header[12:], counter)
+	binary.BigEndian.PutUint32(header[16:], p.dataID)
+	binary.BigEndian.PutUint64(header[0:], p.crc(data))
+	return nil
+}
+
+func (p *p07) Verify(data []byte) (uint32, error) {
+	if err := checkLength(len(data), p.minLength, p.maxLength); err != nil {
+		return 0, err
+	}
+	header := data[p.offset:]
+	if int(binary.BigEndian.Uint32(header[8:])) != len(data) {
+		return 0, ErrLength
+	}
+	if binary.BigEndian.Uint64(header[0:]) != p.crc(data) {
+		return 0, ErrCRC
+	}
+	if binary.BigEndian.Uint32(header[16:]) != p.dataID {
+		return 0, ErrDataID
+	}
+	return binary.BigEndian.Uint32(header[12:]), nil
+}
+
+func (p *p07) crc(data []byte) uint64 {
+	return p.table.CRC(crcExcluding(p.table, data, p.offset, p.offset+8))
+}
@@ -0,0 +1,136 @@
+package e2e
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ast-dd/crc"
+)
+
+const (
+	p05HeaderSize = 3
+	p06HeaderSize = 5
+)
+
+// P05Config configures profile P05.
+type P05Config struct {
+	DataID uint16
+	Offset int // Offset of the header in bytes
+	Length int // Length of protected data in bytes, at most 4096
+}
+
+// P06Config configures profile P06.
+type P06Config struct {
+	DataID    uint16
+	Offset    int // Offset of the header in bytes
+	MinLength int // MinLength is the minimal length of data in bytes, at least Offset plus header size
+	MaxLength int // MaxLength is the maximal length of data in bytes, at most 4096, default 4096
+}
+
+// p05 implements profile P05: little endian 16 bit CRC followed by 8 bit counter,
+// CRC covers data except CRC followed by DataID in little endian byte order
+type p05 struct {
+	dataID uint16
+	offset int
+	length int
+	table  *crc.Table
+}
+
+// NewP05 creates profile P05 with 8 bit counter and 16 bit CRC. The 3 byte header
+// at given offset holds CRC in little endian byte order and counter.
+func NewP05(config P05Config) (Profile, error) {
+	if config.Offset < 0 || config.Offset+p05HeaderSize > config.Length || config.Length > 4096 {
+		return nil, fmt.Errorf("e2e: invalid data length %d or offset %d", config.Length, config.Offset)
+	}
+	return &p05{config.DataID, config.Offset, config.Length, crc.CachedTable(crc.CRC16CCITTFALSE)}, nil
+}
+
+func (p *p05) MaxCounter() uint32 {
+	return 0xFF
+}
+
+func (p *p05) Protect(data []byte, counter uint32) error {
+	if len(data) != p.length {
+		return ErrLength
+	}
+	if counter > 0xFF {
+		return ErrCounter
+	}
+	data[p.offset+2] = byte(counter)
+	binary.LittleEndian.PutUint16(data[p.offset:], p.crc(data))
+	return nil
+}
+
+func (p *p05) Verify(data []byte) (uint32, error) {
+	if len(data) != p.length {
+		return 0, ErrLength
+	}
+	if binary.LittleEndian.Uint16(data[p.offset:]) != p.crc(data) {
+		return 0, ErrCRC
+	}
+	return uint32(data[p.offset+2]), nil
+}
+
+func (p *p05) crc(data []byte) uint16 {
+	var id [2]byte
+	binary.LittleEndian.PutUint16(id[:], p.dataID)
+	return p.table.CRC16(p.table.UpdateCrc(crcExcluding(p.table, data, p.offset, p.offset+2), id[:]))
+}
+
+// p06 implements profile P06: big endian 16 bit CRC, 16 bit length and 8 bit counter,
+// CRC covers data except CRC followed by DataID in big endian byte order
+type p06 struct {
+	dataID    uint16
+	offset    int
+	minLength int
+	maxLength int
+	table     *crc.Table
+}
+
+// NewP06 creates profile P06 with 8 bit counter and 16 bit CRC. The 5 byte header
+// at given offset holds CRC, data length and counter in big endian byte order.
+func NewP06(config P06Config) (Profile, error) {
+	minLength, maxLength, err := newLengthLimits(config.MinLength, config.MaxLength, config.Offset, p06HeaderSize, 4096)
+	if err != nil {
+		return nil, err
+	}
+	return &p06{config.DataID, config.Offset, minLength, maxLength, crc.CachedTable(crc.CRC16CCITTFALSE)}, nil
+}
+
+func (p *p06) MaxCounter() uint32 {
+	return 0xFF
+}
+
+func (p *p06) Protect(data []byte, counter uint32) error {
+	if err := checkLength(len(data), p.minLength, p.maxLength); err != nil {
+		return err
+	}
+	if counter > 0xFF {
+		return ErrCounter
+	}
+	header := data[p.offset:]
+	binary.BigEndian.PutUint16(header[2:], uint16(len(data)))
+	header[4] = byte(counter)
+	binary.BigEndian.PutUint16(header[0:], p.crc(data))
+	return nil
+}
+
+func (p *p06) Verify(data []byte) (uint32, error) {
+	if err := checkLength(len(data), p.minLength, p.maxLength); err != nil {
+		return 0, err
+	}
+	header := data[p.offset:]
+	if int(binary.BigEndian.Uint16(header[2:])) != len(data) {
+		return 0, ErrLength
+	}
+	if binary.BigEndian.Uint16(header[0:]) != p.crc(data) {
+		return 0, ErrCRC
+	}
+	return uint32(header[4]), nil
+}
+
+func (p *p06) crc(data []byte) uint16 {
+	var id [2]byte
+	binary.BigEndian.PutUint16(id[:], p.dataID)
+	return p.table.CRC16(p.table.UpdateCrc(crcExcluding(p.table, data, p.offset, p.offset+2), id[:]))
+}
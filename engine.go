@@ -0,0 +1,149 @@
+package crc
+
+import "fmt"
+
+// Engine selects the implementation used by Table, trading memory for speed.
+type Engine int
+
+const (
+	// ByteTableEngine processes a byte at a time using 256 entry lookup table (2 KiB).
+	// It is the fastest engine and the one used by NewTable.
+	ByteTableEngine Engine = iota
+	// NibbleTableEngine processes 4 bits at a time using 16 entry lookup table (128 bytes).
+	// Entry i is the CRC register after processing 4 bits of i starting from zero register.
+	NibbleTableEngine
+	// SplitNibbleTableEngine processes a byte at a time using two 16 entry lookup tables (256 bytes),
+	// one for each half of the byte. Entries 0-15 equal ByteTableEngine entries 0-15,
+	// entries 16-31 equal ByteTableEngine entries 0x00, 0x10, ... 0xF0.
+	SplitNibbleTableEngine
+	// BitwiseEngine processes a bit at a time without any lookup table.
+	BitwiseEngine
+)
+
+// String returns the name of the engine
+func (e Engine) String() string {
+	switch e {
+	case ByteTableEngine:
+		return "ByteTableEngine"
+	case NibbleTableEngine:
+		return "NibbleTableEngine"
+	case SplitNibbleTableEngine:
+		return "SplitNibbleTableEngine"
+	case BitwiseEngine:
+		return "BitwiseEngine"
+	}
+	return fmt.Sprintf("Engine(%d)", int(e))
+}
+
+// NewTableWithEngine creates and initializes a new Table for the CRC algorithm specified by the crcParams
+// using given engine. All engines produce the same results, but differ in memory used and speed.
+func NewTableWithEngine(crcParams *Parameters, engine Engine) *Table {
+	if engine == ByteTableEngine {
+		return NewTable(crcParams)
+	}
+
+	ret := &Table{crcParams: *crcParams, engine: engine}
+	ret.mask = (uint64(1) << crcParams.Width) - 1
+	ret.initValue = ret.InitCrcWith(crcParams.Init)
+	ret.poly = crcParams.Polynomial & ret.mask
+	if crcParams.ReflectIn {
+		ret.poly = reflect(ret.poly, crcParams.Width)
+	}
+
+	switch engine {
+	case NibbleTableEngine:
+		ret.crctable = make([]uint64, 16)
+		for i := range ret.crctable {
+			ret.crctable[i] = ret.updateBits(0, uint64(i), 4) & ret.mask
+		}
+	case SplitNibbleTableEngine:
+		ret.crctable = make([]uint64, 32)
+		for i := 0; i < 16; i++ {
+			ret.crctable[i] = ret.updateBits(0, uint64(i), 8) & ret.mask
+			ret.crctable[16+i] = ret.updateBits(0, uint64(i<<4), 8) & ret.mask
+		}
+	case BitwiseEngine:
+	default:
+		panic(fmt.Sprintf("crc: unknown engine %d", engine))
+	}
+	return ret
+}
+
+// Engine returns the engine used by this Table.
+func (t *Table) Engine() Engine {
+	return t.engine
+}
+
+// updateBits feeds lowest count bits of v into CRC register bit by bit.
+// Bits are taken starting with the least significant one for reflected algorithms
+// and with the most significant one otherwise.
+func (t *Table) updateBits(curValue uint64, v uint64, count uint) uint64 {
+	width := t.crcParams.Width
+	for i := uint(0); i < count; i++ {
+		var bit uint64
+		if t.crcParams.ReflectIn {
+			bit = (curValue ^ (v >> i)) & 1
+			curValue >>= 1
+		} else {
+			bit = ((curValue >> (width - 1)) ^ (v >> (count - 1 - i))) & 1
+			curValue <<= 1
+		}
+		if bit != 0 {
+			curValue ^= t.poly
+		}
+	}
+	return curValue
+}
+
+func (t *Table) updateNibble(curValue uint64, p []byte) uint64 {
+	if t.crcParams.ReflectIn {
+		for _, v := range p {
+			curValue = t.crctable[(curValue^uint64(v))&0x0F] ^ (curValue >> 4)
+			curValue = t.crctable[(curValue^uint64(v>>4))&0x0F] ^ (curValue >> 4)
+		}
+		return curValue
+	}
+
+	width := t.crcParams.Width
+	for _, v := range p {
+		for _, nibble := range [2]byte{v >> 4, v & 0x0F} {
+			var top uint64
+			if width >= 4 {
+				top = curValue >> (width - 4)
+			} else {
+				top = curValue << (4 - width)
+			}
+			curValue = t.crctable[(top^uint64(nibble))&0x0F] ^ (curValue << 4)
+		}
+	}
+	return curValue
+}
+
+func (t *Table) updateSplitNibble(curValue uint64, p []byte) uint64 {
+	if t.crcParams.ReflectIn {
+		for _, v := range p {
+			idx := byte(curValue) ^ v
+			curValue = t.crctable[idx&0x0F] ^ t.crctable[16+(idx>>4)] ^ (curValue >> 8)
+		}
+		return curValue
+	}
+
+	width := t.crcParams.Width
+	for _, v := range p {
+		var idx byte
+		if width >= 8 {
+			idx = byte(curValue>>(width-8)) ^ v
+		} else {
+			idx = byte(curValue<<(8-width)) ^ v
+		}
+		curValue = t.crctable[idx&0x0F] ^ t.crctable[16+(idx>>4)] ^ (curValue << 8)
+	}
+	return curValue
+}
+
+func (t *Table) updateBitwise(curValue uint64, p []byte) uint64 {
+	for _, v := range p {
+		curValue = t.updateBits(curValue, uint64(v), 8)
+	}
+	return curValue
+}
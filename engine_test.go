@@ -0,0 +1,139 @@
+package crc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ast-dd/crc"
+)
+
+var engines = []crc.Engine{crc.ByteTableEngine, crc.NibbleTableEngine, crc.SplitNibbleTableEngine, crc.BitwiseEngine}
+
+func TestEngines(t *testing.T) {
+	params := []*crc.Parameters{
+		{Width: 3, Polynomial: 0x03, FinalXor: 0x7},
+		{Width: 3, Polynomial: 0x03, Init: 0x07, ReflectIn: true, ReflectOut: true},
+		{Width: 5, Polynomial: 0x15, ReflectIn: true, ReflectOut: true},
+		{Width: 7, Polynomial: 0x09},
+		{Width: 12, Polynomial: 0x80f, ReflectOut: true},
+		{Width: 24, Polynomial: 0x00065b, Init: 0x555555, ReflectIn: true, ReflectOut: true},
+		{Width: 31, Polynomial: 0x04c11db7, Init: 0x7fffffff, FinalXor: 0x7fffffff},
+	}
+	for _, name := range crc.ParametersNames() {
+		p, _ := crc.GetParameters(name)
+		params = append(params, p)
+	}
+
+	for _, p := range params {
+		for i, testString := range byteTestStrings {
+			data := []byte(testString)
+			want := crc.CalculateCRC(p, data)
+			for _, engine := range engines {
+				table := crc.NewTableWithEngine(p, engine)
+				if table.Engine() != engine {
+					t.Errorf("Engine() = %v, want %v", table.Engine(), engine)
+				}
+				if got := table.CalculateCRC(data); got != want {
+					t.Errorf("%v CalculateCRC(%v, %d) = 0x%X, want 0x%X", engine, crc.FormatParameters(p), i, got, want)
+				}
+
+				h := crc.NewHashWithTable(table)
+				h.Update(data[:len(data)/3])
+				h.Update(data[len(data)/3:])
+				if got := h.CRC(); got != want {
+					t.Errorf("%v Hash.CRC(%v, %d) = 0x%X, want 0x%X", engine, crc.FormatParameters(p), i, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestEngineEntries(t *testing.T) {
+	byteTable := crc.NewTable(crc.CRC16XMODEM).Entries()
+	tests := []struct {
+		engine crc.Engine
+		want   []uint64
+	}{
+		{crc.ByteTableEngine, byteTable},
+		{crc.NibbleTableEngine, []uint64{
+			0x0000, 0x1021, 0x2042, 0x3063, 0x4084, 0x50A5, 0x60C6, 0x70E7,
+			0x8108, 0x9129, 0xA14A, 0xB16B, 0xC18C, 0xD1AD, 0xE1CE, 0xF1EF,
+		}},
+		{crc.SplitNibbleTableEngine, append(append([]uint64{}, byteTable[:16]...),
+			byteTable[0x00], byteTable[0x10], byteTable[0x20], byteTable[0x30], byteTable[0x40], byteTable[0x50], byteTable[0x60], byteTable[0x70],
+			byteTable[0x80], byteTable[0x90], byteTable[0xA0], byteTable[0xB0], byteTable[0xC0], byteTable[0xD0], byteTable[0xE0], byteTable[0xF0])},
+		{crc.BitwiseEngine, []uint64{}},
+	}
+	for _, tt := range tests {
+		got := crc.NewTableWithEngine(crc.CRC16XMODEM, tt.engine).Entries()
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("%v Entries() = %X, want %X", tt.engine, got, tt.want)
+		}
+	}
+}
+
+func TestTableUpdateBits(t *testing.T) {
+	params := []*crc.Parameters{
+		{Width: 5, Polynomial: 0x05, Init: 0x1F, ReflectIn: true, ReflectOut: true, FinalXor: 0x1F},
+		{Width: 7, Polynomial: 0x09},
+	}
+	for _, name := range crc.ParametersNames() {
+		p, _ := crc.GetParameters(name)
+		params = append(params, p)
+	}
+
+	data := []byte(byteTestStrings[2])
+	for _, p := range params {
+		for _, engine := range engines {
+			table := crc.NewTableWithEngine(p, engine)
+			// bits of data in the order they are processed
+			var bits []uint64
+			for _, b := range data {
+				for i := 0; i < 8; i++ {
+					if p.ReflectIn {
+						bits = append(bits, uint64(b>>i)&1)
+					} else {
+						bits = append(bits, uint64(b>>(7-i))&1)
+					}
+				}
+			}
+
+			curValue := table.InitCrc()
+			for start, count := 0, 1; start < len(bits); start, count = start+count, count%64+1 {
+				if start+count > len(bits) {
+					count = len(bits) - start
+				}
+				var v uint64
+				for i, bit := range bits[start : start+count] {
+					if p.ReflectIn {
+						v |= bit << uint(i)
+					} else {
+						v = v<<1 | bit
+					}
+				}
+				// bits above count must be ignored
+				garbage := ^(uint64(1)<<uint(count) - 1) & 0xF0
+				curValue = table.UpdateBits(curValue, v|garbage, uint(count))
+			}
+			if got, want := table.CRC(curValue), table.CalculateCRC(data); got != want {
+				t.Errorf("%+v %v UpdateBits() = 0x%X, want 0x%X", *p, engine, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkEngines(b *testing.B) {
+	data := []byte(byteTestStrings[3])
+	for _, p := range []*crc.Parameters{crc.CRC8, crc.CRC16MODBUS, crc.CRC32} {
+		name, _ := crc.GetParametersName(p)
+		for _, engine := range engines {
+			table := crc.NewTableWithEngine(p, engine)
+			b.Run(fmt.Sprintf("%s/%v/%dB", name, engine, 8*len(table.Entries())), func(b *testing.B) {
+				b.SetBytes(int64(len(data)))
+				for i := 0; i < b.N; i++ {
+					table.CalculateCRC(data)
+				}
+			})
+		}
+	}
+}
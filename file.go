@@ -0,0 +1,260 @@
+package crc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// defaultSumBufSize is the size of buffers used by SumReader and SumFile unless specified otherwise
+const defaultSumBufSize = 32 * 1024
+
+var sumBufPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, defaultSumBufSize)
+		return &buf
+	},
+}
+
+// errMmapNotSupported makes SumFile fall back to reading the file
+var errMmapNotSupported = errors.New("mmap not supported")
+
+// errSparseNotSupported makes SumFile process the file as if it had no holes
+var errSparseNotSupported = errors.New("sparse files not supported")
+
+// SumOption configures SumReader, SumFile and WalkDir.
+type SumOption func(*sumConfig)
+
+type sumConfig struct {
+	buf           []byte
+	progress      func(n int64)
+	mmapThreshold int64
+	concurrency   int
+}
+
+// WithBuffer makes the calculation use supplied buffer instead of one taken from internal pool.
+// The buffer must not be used concurrently, so it is ignored by WalkDir.
+func WithBuffer(buf []byte) SumOption {
+	return func(c *sumConfig) { c.buf = buf }
+}
+
+// WithProgress registers a callback receiving number of bytes processed so far.
+// For WalkDir, it receives total number of bytes processed in all files.
+func WithProgress(progress func(n int64)) SumOption {
+	return func(c *sumConfig) { c.progress = progress }
+}
+
+// WithMmapThreshold makes SumFile memory map files of at least size bytes instead of reading them.
+// Zero (the default) disables memory mapping. It has no effect on platforms without mmap support.
+func WithMmapThreshold(size int64) SumOption {
+	return func(c *sumConfig) { c.mmapThreshold = size }
+}
+
+// WithConcurrency limits the number of files WalkDir checksums in parallel.
+// It defaults to runtime.NumCPU().
+func WithConcurrency(n int) SumOption {
+	return func(c *sumConfig) { c.concurrency = n }
+}
+
+func newSumConfig(opts []SumOption) *sumConfig {
+	c := &sumConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// SumReader calculates CRC of all data read from r until io.EOF.
+// It stops early and returns ctx.Err() if ctx is done.
+func SumReader(ctx context.Context, r io.Reader, crcParams *Parameters, opts ...SumOption) (uint64, error) {
+	return sumReader(ctx, r, NewTable(crcParams), newSumConfig(opts))
+}
+
+// SumFile calculates CRC of the file with given path.
+// On Linux, holes of sparse files are skipped using Table.UpdateZeros instead of reading them.
+// It stops early and returns ctx.Err() if ctx is done.
+func SumFile(ctx context.Context, path string, crcParams *Parameters, opts ...SumOption) (uint64, error) {
+	return sumFile(ctx, path, NewTable(crcParams), newSumConfig(opts))
+}
+
+func sumReader(ctx context.Context, r io.Reader, table *Table, c *sumConfig) (uint64, error) {
+	crc, _, err := updateReader(ctx, r, table, c, table.InitCrc(), 0)
+	if err != nil {
+		return 0, err
+	}
+	return table.CRC(crc), nil
+}
+
+// updateReader updates crc with all data read from r, total is the number of bytes processed before
+func updateReader(ctx context.Context, r io.Reader, table *Table, c *sumConfig, crc uint64, total int64) (uint64, int64, error) {
+	buf := c.buf
+	if len(buf) == 0 {
+		pooled := sumBufPool.Get().(*[]byte)
+		defer sumBufPool.Put(pooled)
+		buf = *pooled
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, 0, err
+		}
+		n, err := r.Read(buf)
+		crc = table.UpdateCrc(crc, buf[:n])
+		if n > 0 {
+			total += int64(n)
+			if c.progress != nil {
+				c.progress(total)
+			}
+		}
+		if err == io.EOF {
+			return crc, total, nil
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+}
+
+func sumFile(ctx context.Context, path string, table *Table, c *sumConfig) (uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if crc, err := sumSparse(ctx, f, fi, table, c); err != errSparseNotSupported {
+		return crc, err
+	}
+	if c.mmapThreshold > 0 {
+		if fi.Size() >= c.mmapThreshold {
+			if crc, err := sumMmap(ctx, f, fi.Size(), table, c); err != errMmapNotSupported {
+				return crc, err
+			}
+		}
+	}
+	return sumReader(ctx, f, table, c)
+}
+
+// sumBytes calculates CRC of data already in memory checking ctx and reporting progress
+// in chunks of defaultSumBufSize bytes.
+func sumBytes(ctx context.Context, data []byte, table *Table, c *sumConfig) (uint64, error) {
+	crc := table.InitCrc()
+	for done := 0; done < len(data); {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		end := done + defaultSumBufSize
+		if end > len(data) {
+			end = len(data)
+		}
+		crc = table.UpdateCrc(crc, data[done:end])
+		done = end
+		if c.progress != nil {
+			c.progress(int64(done))
+		}
+	}
+	return table.CRC(crc), nil
+}
+
+// FileSum is the CRC of a single file found by WalkDir.
+type FileSum struct {
+	Path string // Path of the file, as passed to fs.WalkDirFunc
+	CRC  uint64 // CRC of file contents
+}
+
+// WalkDir calculates CRC of every regular file in the tree rooted at root.
+// Files are processed concurrently, results are sorted by path.
+// The first error encountered cancels the remaining work and is returned.
+func WalkDir(ctx context.Context, root string, crcParams *Parameters, opts ...SumOption) ([]FileSum, error) {
+	c := newSumConfig(opts)
+	concurrency := c.concurrency
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		results  []FileSum
+		firstErr error
+		total    int64
+	)
+	setErr := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+		mu.Unlock()
+	}
+
+	table := NewTable(crcParams)
+	paths := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fileConfig := &sumConfig{mmapThreshold: c.mmapThreshold}
+			var last int64
+			if c.progress != nil {
+				fileConfig.progress = func(n int64) {
+					mu.Lock()
+					total += n - last
+					last = n
+					c.progress(total)
+					mu.Unlock()
+				}
+			}
+			for path := range paths {
+				last = 0
+				crc, err := sumFile(ctx, path, table, fileConfig)
+				if err != nil {
+					setErr(err)
+					continue
+				}
+				mu.Lock()
+				results = append(results, FileSum{Path: path, CRC: crc})
+				mu.Unlock()
+			}
+		}()
+	}
+
+	walkErr := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		select {
+		case paths <- path:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	close(paths)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if walkErr != nil {
+		return nil, walkErr
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Path < results[j].Path })
+	return results, nil
+}
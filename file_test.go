@@ -0,0 +1,161 @@
+package crc_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ast-dd/crc"
+)
+
+func writeTestFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestSumReader(t *testing.T) {
+	data := strings.Repeat(byteTestStrings[3], 200)
+	want := crc.CalculateCRC(crc.CRC32C, []byte(data))
+
+	var progress int64
+	got, err := crc.SumReader(context.Background(), strings.NewReader(data), crc.CRC32C,
+		crc.WithBuffer(make([]byte, 1000)), crc.WithProgress(func(n int64) { progress = n }))
+	if err != nil {
+		t.Fatalf("SumReader() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("SumReader() = 0x%X, want 0x%X", got, want)
+	}
+	if progress != int64(len(data)) {
+		t.Errorf("SumReader() reported progress %d, want %d", progress, len(data))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = crc.SumReader(ctx, strings.NewReader(data), crc.CRC32C); err != context.Canceled {
+		t.Errorf("SumReader() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestSumFile(t *testing.T) {
+	data := strings.Repeat(byteTestStrings[3], 200)
+	root := writeTestFiles(t, map[string]string{"data": data, "empty": ""})
+
+	for _, threshold := range []int64{0, 1} {
+		got, err := crc.SumFile(context.Background(), filepath.Join(root, "data"), crc.CRC64ECMA, crc.WithMmapThreshold(threshold))
+		if err != nil {
+			t.Fatalf("SumFile() error = %v", err)
+		}
+		if want := crc.CalculateCRC(crc.CRC64ECMA, []byte(data)); got != want {
+			t.Errorf("SumFile(mmap threshold %d) = 0x%X, want 0x%X", threshold, got, want)
+		}
+
+		got, err = crc.SumFile(context.Background(), filepath.Join(root, "empty"), crc.CRC64ECMA, crc.WithMmapThreshold(threshold))
+		if err != nil {
+			t.Fatalf("SumFile() error = %v", err)
+		}
+		if want := crc.CalculateCRC(crc.CRC64ECMA, nil); got != want {
+			t.Errorf("SumFile(empty, mmap threshold %d) = 0x%X, want 0x%X", threshold, got, want)
+		}
+	}
+
+	if _, err := crc.SumFile(context.Background(), filepath.Join(root, "missing"), crc.CRC32); !os.IsNotExist(err) {
+		t.Errorf("SumFile() error = %v, want not exist", err)
+	}
+}
+
+func TestSumFileSparse(t *testing.T) {
+	const size = 8 << 20
+	path := filepath.Join(t.TempDir(), "sparse")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make([]byte, size)
+	for _, offset := range []int64{0, 3 << 20, 3<<20 + 100} {
+		copy(want[offset:], byteTestStrings[3])
+		if _, err = f.WriteAt([]byte(byteTestStrings[3]), offset); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = f.Truncate(size); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	var progress int64
+	got, err := crc.SumFile(context.Background(), path, crc.CRC32, crc.WithProgress(func(n int64) { progress = n }))
+	if err != nil {
+		t.Fatalf("SumFile() error = %v", err)
+	}
+	if want := crc.CalculateCRC(crc.CRC32, want); got != want {
+		t.Errorf("SumFile(sparse) = 0x%X, want 0x%X", got, want)
+	}
+	if progress != size {
+		t.Errorf("SumFile(sparse) reported progress %d, want %d", progress, size)
+	}
+}
+
+func TestWalkDir(t *testing.T) {
+	files := map[string]string{}
+	for i, s := range byteTestStrings {
+		files["dir"+string(rune('d'-i))+"/f"] = s
+		files[string(rune('a'+i))] = strings.Repeat(s, i+1)
+	}
+	root := writeTestFiles(t, files)
+
+	var progress int64
+	got, err := crc.WalkDir(context.Background(), root, crc.CRC32, crc.WithConcurrency(3), crc.WithProgress(func(n int64) { progress = n }))
+	if err != nil {
+		t.Fatalf("WalkDir() error = %v", err)
+	}
+	if len(got) != len(files) {
+		t.Fatalf("WalkDir() returned %d results, want %d", len(got), len(files))
+	}
+	var total int64
+	for i, fs := range got {
+		if i > 0 && got[i-1].Path >= fs.Path {
+			t.Errorf("WalkDir() results not sorted: %q before %q", got[i-1].Path, fs.Path)
+		}
+		rel, _ := filepath.Rel(root, fs.Path)
+		content := files[filepath.ToSlash(rel)]
+		total += int64(len(content))
+		if want := crc.CalculateCRC(crc.CRC32, []byte(content)); fs.CRC != want {
+			t.Errorf("WalkDir() CRC of %q = 0x%X, want 0x%X", rel, fs.CRC, want)
+		}
+	}
+	if progress != total {
+		t.Errorf("WalkDir() reported progress %d, want %d", progress, total)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = crc.WalkDir(ctx, root, crc.CRC32); err != context.Canceled {
+		t.Errorf("WalkDir() error = %v, want %v", err, context.Canceled)
+	}
+
+	if _, err = crc.WalkDir(context.Background(), filepath.Join(root, "missing"), crc.CRC32); err == nil {
+		t.Errorf("WalkDir() on missing root returned no error")
+	}
+}
+
+func BenchmarkSumReader(b *testing.B) {
+	data := bytes.Repeat([]byte(byteTestStrings[3]), 1000)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		crc.SumReader(context.Background(), bytes.NewReader(data), crc.CRC32)
+	}
+}
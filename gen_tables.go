@@ -0,0 +1,92 @@
+//go:build ignore
+
+// This program generates tables_gen.go holding precomputed lookup tables
+// of all known CRC parameters. Run it using "go generate".
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/ast-dd/crc"
+)
+
+func main() {
+	var buf bytes.Buffer
+	// static tables take about 80 KiB, too much for microcontrollers
+	buf.WriteString("// Code generated by gen_tables.go; DO NOT EDIT.\n\n//go:build !tinygo\n\npackage crc\n\n")
+
+	// lookup table only depends on width, polynomial and input reflection
+	var keys []crc.Parameters
+	seen := map[crc.Parameters]bool{}
+	for _, name := range crc.ParametersNames() {
+		params, err := crc.GetParameters(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		key := crc.Parameters{Width: params.Width, Polynomial: params.Polynomial, ReflectIn: params.ReflectIn}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Width != keys[j].Width {
+			return keys[i].Width < keys[j].Width
+		}
+		if keys[i].Polynomial != keys[j].Polynomial {
+			return keys[i].Polynomial < keys[j].Polynomial
+		}
+		return !keys[i].ReflectIn && keys[j].ReflectIn
+	})
+
+	buf.WriteString("// staticTables holds precomputed lookup tables of known CRC algorithms\n")
+	buf.WriteString("var staticTables = map[tableKey]*[256]uint64{\n")
+	for _, key := range keys {
+		fmt.Fprintf(&buf, "\t{width: %d, polynomial: 0x%X, reflectIn: %t}: &%s,\n", key.Width, key.Polynomial, key.ReflectIn, tableName(key))
+	}
+	buf.WriteString("}\n")
+
+	for _, key := range keys {
+		// same as NewTable does when building the table
+		tableParams := key
+		tableParams.ReflectOut = key.ReflectIn
+
+		digits := int(key.Width+3) / 4
+		perRow := 8
+		if digits > 8 {
+			perRow = 4
+		}
+		fmt.Fprintf(&buf, "\nvar %s = [256]uint64{", tableName(key))
+		for i := 0; i < 256; i++ {
+			if i%perRow == 0 {
+				buf.WriteString("\n")
+			} else {
+				buf.WriteString(" ")
+			}
+			fmt.Fprintf(&buf, "0x%0*X,", digits, crc.CalculateCRC(&tableParams, []byte{byte(i)}))
+		}
+		buf.WriteString("\n}\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile("tables_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func tableName(key crc.Parameters) string {
+	name := fmt.Sprintf("crc%dx%X", key.Width, key.Polynomial)
+	if key.ReflectIn {
+		name += "Reflected"
+	}
+	return name + "Table"
+}
@@ -0,0 +1,44 @@
+package crc
+
+import (
+	"fmt"
+	"hash"
+)
+
+// hash32 wraps Hash to implement hash.Hash32 interface.
+type hash32 struct {
+	*Hash
+}
+
+// Sum32 returns current CRC value for the data processed so far.
+// See hash.Hash32 interface.
+func (h hash32) Sum32() uint32 { return uint32(h.CRC()) }
+
+// hash64 wraps Hash to implement hash.Hash64 interface.
+type hash64 struct {
+	*Hash
+}
+
+// Sum64 returns current CRC value for the data processed so far.
+// See hash.Hash64 interface.
+func (h hash64) Sum64() uint64 { return h.CRC() }
+
+// NewHash32 creates a new hash.Hash32 instance configured for table driven
+// CRC calculation according to parameters specified.
+// It returns an error for CRC algorithms wider than 32 bits.
+func NewHash32(crcParams *Parameters) (hash.Hash32, error) {
+	if crcParams.Width == 0 || crcParams.Width > 32 {
+		return nil, fmt.Errorf("unsupported width for hash.Hash32: %d", crcParams.Width)
+	}
+	return hash32{NewHash(crcParams)}, nil
+}
+
+// NewHash64 creates a new hash.Hash64 instance configured for table driven
+// CRC calculation according to parameters specified.
+// It returns an error for CRC algorithms wider than 64 bits.
+func NewHash64(crcParams *Parameters) (hash.Hash64, error) {
+	if crcParams.Width == 0 || crcParams.Width > 64 {
+		return nil, fmt.Errorf("unsupported width for hash.Hash64: %d", crcParams.Width)
+	}
+	return hash64{NewHash(crcParams)}, nil
+}
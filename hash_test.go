@@ -0,0 +1,66 @@
+package crc_test
+
+import (
+	"hash/crc32"
+	"hash/crc64"
+	"testing"
+
+	"github.com/ast-dd/crc"
+)
+
+func TestNewHash32(t *testing.T) {
+	tests := []struct {
+		name      string
+		crcParams *crc.Parameters
+		want      uint32
+		wantErr   bool
+	}{
+		{"CRC8", crc.CRC8, 0xF4, false},
+		{"CRC16MODBUS", crc.CRC16MODBUS, 0x4B37, false},
+		{"CRC32", crc.CRC32, crc32.ChecksumIEEE([]byte("123456789")), false},
+		{"CRC32C", crc.CRC32C, crc32.Checksum([]byte("123456789"), crc32.MakeTable(crc32.Castagnoli)), false},
+		{"CRC64ECMA", crc.CRC64ECMA, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := crc.NewHash32(tt.crcParams)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewHash32() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			h.Write([]byte("12345"))
+			h.Write([]byte("6789"))
+			if got := h.Sum32(); got != tt.want {
+				t.Errorf("Sum32() = 0x%08x, want 0x%08x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewHash64(t *testing.T) {
+	data := []byte("Introduction on CRC calculations")
+
+	h, err := crc.NewHash64(crc.CRC64ECMA)
+	if err != nil {
+		t.Fatalf("NewHash64() error = %v", err)
+	}
+	h.Write(data)
+	if got, want := h.Sum64(), crc64.Checksum(data, crc64.MakeTable(crc64.ECMA)); got != want {
+		t.Errorf("Sum64() = 0x%016x, want 0x%016x", got, want)
+	}
+
+	h, err = crc.NewHash64(crc.CRC64ISO)
+	if err != nil {
+		t.Fatalf("NewHash64() error = %v", err)
+	}
+	h.Write(data)
+	if got, want := h.Sum64(), crc64.Checksum(data, crc64.MakeTable(crc64.ISO)); got != want {
+		t.Errorf("Sum64() = 0x%016x, want 0x%016x", got, want)
+	}
+
+	if _, err = crc.NewHash64(&crc.Parameters{Width: 65, Polynomial: 1}); err == nil {
+		t.Errorf("NewHash64() accepted width 65")
+	}
+}
@@ -0,0 +1,233 @@
+// Package hdlc implements HDLC-like framing as used by PPP (RFC 1662).
+//
+// Frames are delimited by Flag bytes. Flag and Escape bytes within a frame, as well as
+// control characters selected by the async control character map (ACCM), are transmitted
+// as Escape followed by the byte xor 0x20. Each frame ends with a frame check sequence (FCS),
+// CRC-16/X-25 or CRC-32 of the frame contents transmitted low byte first.
+package hdlc
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	"github.com/ast-dd/crc"
+)
+
+const (
+	// Flag delimits frames.
+	Flag = 0x7E
+	// Escape precedes escaped bytes.
+	Escape = 0x7D
+	// escapeXor is applied to escaped bytes
+	escapeXor = 0x20
+)
+
+const (
+	// DefaultACCM escapes all control characters, as required before it is negotiated otherwise.
+	DefaultACCM = 0xFFFFFFFF
+	// DefaultMaxFrameSize is the default limit of frame size including FCS.
+	DefaultMaxFrameSize = 65536
+)
+
+const (
+	// GoodFCS16 is the CRC register value, before the final xor, after processing a frame with valid FCS-16.
+	GoodFCS16 = 0xF0B8
+	// GoodFCS32 is the CRC register value, before the final xor, after processing a frame with valid FCS-32.
+	GoodFCS32 = 0xDEBB20E3
+)
+
+// FCS selects the frame check sequence.
+type FCS int
+
+const (
+	// FCS16 uses 16 bit FCS, CRC-16/X-25.
+	FCS16 FCS = iota
+	// FCS32 uses 32 bit FCS, CRC-32.
+	FCS32
+)
+
+func (f FCS) parameters() (params *crc.Parameters, good uint64) {
+	if f == FCS32 {
+		return crc.CRC32, GoodFCS32
+	}
+	return crc.CRC16X25, GoodFCS16
+}
+
+// Config configures Reader and Writer. The zero value uses FCS-16 and escapes no control characters,
+// use DefaultConfig for the settings required before link negotiation.
+type Config struct {
+	FCS          FCS    // FCS selects the frame check sequence
+	ACCM         uint32 // ACCM has bit n set if control character n must be escaped
+	MaxFrameSize int    // MaxFrameSize limits size of received frames including FCS, DefaultMaxFrameSize if 0
+}
+
+// DefaultConfig returns configuration using FCS-16 and escaping all control characters.
+func DefaultConfig() Config {
+	return Config{FCS: FCS16, ACCM: DefaultACCM, MaxFrameSize: DefaultMaxFrameSize}
+}
+
+// mustEscape reports whether byte b is transmitted escaped
+func (c *Config) mustEscape(b byte) bool {
+	return b == Flag || b == Escape || (b < 0x20 && c.ACCM&(1<<b) != 0)
+}
+
+var (
+	// ErrBadFCS is returned by Reader for frames with invalid FCS.
+	ErrBadFCS = errors.New("hdlc: bad FCS")
+	// ErrAborted is returned by Reader for frames aborted by Escape followed by Flag.
+	ErrAborted = errors.New("hdlc: frame aborted")
+	// ErrFrameTooShort is returned by Reader for frames shorter than FCS.
+	ErrFrameTooShort = errors.New("hdlc: frame too short")
+	// ErrFrameTooLong is returned by Reader for frames longer than Config.MaxFrameSize.
+	ErrFrameTooLong = errors.New("hdlc: frame too long")
+)
+
+// Writer writes each Write call as a single frame to the underlying writer.
+type Writer struct {
+	w      io.Writer
+	config Config
+	hash   *crc.Hash
+	buf    []byte
+}
+
+// NewWriter creates a new Writer writing frames to w.
+func NewWriter(w io.Writer, config Config) *Writer {
+	params, _ := config.FCS.parameters()
+	return &Writer{w: w, config: config, hash: crc.NewHashWithTable(crc.CachedTable(params))}
+}
+
+// Write writes p as a single frame followed by its FCS.
+// It returns len(p) if the whole frame was written.
+func (w *Writer) Write(p []byte) (n int, err error) {
+	w.hash.Reset()
+	w.hash.Update(p)
+	var fcs [4]byte
+	fcsSize := w.hash.Table().PutCRC(fcs[:], w.hash.CRC())
+
+	buf := append(w.buf[:0], Flag)
+	buf = w.appendEscaped(buf, p)
+	buf = w.appendEscaped(buf, fcs[:fcsSize])
+	buf = append(buf, Flag)
+	w.buf = buf
+
+	if _, err = w.w.Write(buf); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *Writer) appendEscaped(dst, p []byte) []byte {
+	for _, b := range p {
+		if w.config.mustEscape(b) {
+			dst = append(dst, Escape, b^escapeXor)
+		} else {
+			dst = append(dst, b)
+		}
+	}
+	return dst
+}
+
+// Reader reads frames from the underlying reader.
+type Reader struct {
+	r      io.ByteReader
+	config Config
+	hash   *crc.Hash
+	good   uint64
+	buf    []byte
+}
+
+// NewReader creates a new Reader reading frames from r.
+func NewReader(r io.Reader, config Config) *Reader {
+	if config.MaxFrameSize <= 0 {
+		config.MaxFrameSize = DefaultMaxFrameSize
+	}
+	params, good := config.FCS.parameters()
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &Reader{
+		r:      br,
+		config: config,
+		hash:   crc.NewHashWithTable(crc.CachedTable(params)),
+		good:   good ^ params.FinalXor,
+	}
+}
+
+// ReadFrame returns the contents of the next frame without FCS.
+// Errors of invalid frames, such as ErrBadFCS, can be skipped by calling ReadFrame again.
+// It returns io.EOF at the end of input between frames and io.ErrUnexpectedEOF within a frame.
+// The returned slice is valid only until the next call to ReadFrame or Read.
+func (r *Reader) ReadFrame() ([]byte, error) {
+	buf := r.buf[:0]
+	escaped, discard := false, false
+	var err error
+	for {
+		var b byte
+		if b, err = r.r.ReadByte(); err != nil {
+			break
+		}
+		if b == Flag {
+			if escaped {
+				err = ErrAborted
+				break
+			}
+			if discard {
+				err = ErrFrameTooLong
+				break
+			}
+			if len(buf) > 0 {
+				break
+			}
+			continue
+		}
+		if escaped {
+			b ^= escapeXor
+			escaped = false
+		} else if b == Escape {
+			escaped = true
+			continue
+		} else if b < 0x20 && r.config.ACCM&(1<<b) != 0 {
+			// control characters which must be escaped were inserted by the link, ignore them
+			continue
+		}
+		if len(buf) == r.config.MaxFrameSize {
+			discard = true
+			continue
+		}
+		buf = append(buf, b)
+	}
+	r.buf = buf
+
+	if err == io.EOF && (len(buf) > 0 || escaped || discard) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fcsSize := r.hash.Size()
+	if len(buf) < fcsSize {
+		return nil, ErrFrameTooShort
+	}
+	r.hash.Reset()
+	r.hash.Update(buf)
+	if r.hash.CRC() != r.good {
+		return nil, ErrBadFCS
+	}
+	return buf[:len(buf)-fcsSize], nil
+}
+
+// Read reads the contents of the next frame into p. It returns io.ErrShortBuffer,
+// discarding the frame, if p is too small.
+func (r *Reader) Read(p []byte) (n int, err error) {
+	frame, err := r.ReadFrame()
+	if err != nil {
+		return 0, err
+	}
+	if len(frame) > len(p) {
+		return 0, io.ErrShortBuffer
+	}
+	return copy(p, frame), nil
+}
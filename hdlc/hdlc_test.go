@@ -0,0 +1,111 @@
+package hdlc_test
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/ast-dd/crc/hdlc"
+)
+
+func TestWriter(t *testing.T) {
+	tests := []struct {
+		config  hdlc.Config
+		payload []byte
+		want    []byte
+	}{
+		{hdlc.DefaultConfig(), []byte("123456789"), []byte("\x7e123456789\x6e\x90\x7e")},
+		{hdlc.Config{FCS: hdlc.FCS32}, []byte("123456789"), []byte("\x7e123456789\x26\x39\xf4\xcb\x7e")},
+		{hdlc.DefaultConfig(), []byte{0x7E, 0x7D, 0x01, 0x20}, []byte{0x7E, 0x7D, 0x5E, 0x7D, 0x5D, 0x7D, 0x21, 0x20, 0xA4, 0x4F, 0x7E}},
+		{hdlc.Config{}, []byte{0x7E, 0x7D, 0x01, 0x20}, []byte{0x7E, 0x7D, 0x5E, 0x7D, 0x5D, 0x01, 0x20, 0xA4, 0x4F, 0x7E}},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		n, err := hdlc.NewWriter(&buf, tt.config).Write(tt.payload)
+		if err != nil || n != len(tt.payload) {
+			t.Errorf("Write() = %d, %v", n, err)
+		}
+		if !bytes.Equal(buf.Bytes(), tt.want) {
+			t.Errorf("Write(% X) wrote % X, want % X", tt.payload, buf.Bytes(), tt.want)
+		}
+
+		frame, err := hdlc.NewReader(&buf, tt.config).ReadFrame()
+		if err != nil || !bytes.Equal(frame, tt.payload) {
+			t.Errorf("ReadFrame() = % X, %v, want % X", frame, err, tt.payload)
+		}
+	}
+}
+
+func TestPipe(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	frames := make([][]byte, 50)
+	for i := range frames {
+		frames[i] = make([]byte, rnd.Intn(300))
+		rnd.Read(frames[i])
+	}
+
+	for _, config := range []hdlc.Config{hdlc.DefaultConfig(), {FCS: hdlc.FCS32, ACCM: 0x000A0000}} {
+		pr, pw := io.Pipe()
+		go func() {
+			w := hdlc.NewWriter(pw, config)
+			for _, frame := range frames {
+				w.Write(frame)
+			}
+			pw.Close()
+		}()
+
+		r := hdlc.NewReader(pr, config)
+		buf := make([]byte, 300)
+		for i, want := range frames {
+			n, err := r.Read(buf)
+			if err != nil || !bytes.Equal(buf[:n], want) {
+				t.Fatalf("frame %d = % X, %v, want % X", i, buf[:n], err, want)
+			}
+		}
+		if _, err := r.Read(buf); err != io.EOF {
+			t.Errorf("Read() error = %v, want io.EOF", err)
+		}
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	var good bytes.Buffer
+	hdlc.NewWriter(&good, hdlc.DefaultConfig()).Write([]byte("123456789"))
+	frame := good.Bytes()
+
+	corrupted := append([]byte{}, frame...)
+	corrupted[3] ^= 0x01
+	aborted := append(append([]byte{}, frame[:5]...), hdlc.Escape, hdlc.Flag)
+	// control characters are ignored, empty frames are skipped
+	noisy := []byte{hdlc.Flag, hdlc.Flag, '1', '2', 0x11, '3', '4', '5', '6', '7', 0x13, '8', '9', 0x6E, 0x90, hdlc.Flag}
+
+	var stream []byte
+	for _, part := range [][]byte{corrupted, frame, aborted, frame, {hdlc.Flag, 0x41, hdlc.Flag}, noisy, frame[:6]} {
+		stream = append(stream, part...)
+	}
+
+	r := hdlc.NewReader(bytes.NewReader(stream), hdlc.DefaultConfig())
+	for i, want := range []error{hdlc.ErrBadFCS, nil, hdlc.ErrAborted, nil, hdlc.ErrFrameTooShort, nil, io.ErrUnexpectedEOF} {
+		got, err := r.ReadFrame()
+		if err != want {
+			t.Errorf("frame %d error = %v, want %v", i, err, want)
+		}
+		if err == nil && string(got) != "123456789" {
+			t.Errorf("frame %d = %q", i, got)
+		}
+	}
+
+	r = hdlc.NewReader(bytes.NewReader(append(append([]byte{}, frame...), frame...)), hdlc.Config{MaxFrameSize: 10})
+	if _, err := r.ReadFrame(); err != hdlc.ErrFrameTooLong {
+		t.Errorf("ReadFrame() error = %v, want %v", err, hdlc.ErrFrameTooLong)
+	}
+	if _, err := r.Read(make([]byte, 5)); err != hdlc.ErrFrameTooLong {
+		t.Errorf("Read() error = %v, want %v", err, hdlc.ErrFrameTooLong)
+	}
+
+	r = hdlc.NewReader(bytes.NewReader(frame), hdlc.DefaultConfig())
+	if _, err := r.Read(make([]byte, 5)); err != io.ErrShortBuffer {
+		t.Errorf("Read() error = %v, want %v", err, io.ErrShortBuffer)
+	}
+}
@@ -0,0 +1,195 @@
+// Package manifest creates and verifies checksum manifests listing CRC of every file in a directory tree.
+//
+// Two formats are supported: SFV (Simple File Verification), which is limited to CRC-32,
+// and a generic format naming the CRC algorithm in its header line:
+//
+//	# crc manifest CRC16MODBUS
+//	4B37  path/to/file
+//
+// Algorithms not known to package crc are named by their RevEng style definition.
+package manifest
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ast-dd/crc"
+)
+
+// Format of a manifest file
+type Format int
+
+const (
+	// Generic format lists "<hex crc>  <path>" lines after a header naming the algorithm.
+	Generic Format = iota
+	// SFV format lists "<path> <hex crc32>" lines. It can only be used with CRC-32.
+	SFV
+)
+
+// genericHeader starts the first line of manifests in Generic format
+const genericHeader = "# crc manifest "
+
+// Entry is the CRC of a single file listed in a manifest.
+type Entry struct {
+	Path string // Path relative to manifest root, using forward slashes
+	CRC  uint64 // CRC of file contents
+}
+
+// Manifest lists CRC of files calculated using the same CRC algorithm.
+type Manifest struct {
+	Parameters *crc.Parameters
+	Entries    []Entry
+}
+
+// Create calculates CRC of every regular file in the tree rooted at root.
+// Entries are sorted by path.
+func Create(ctx context.Context, root string, crcParams *crc.Parameters, opts ...crc.SumOption) (*Manifest, error) {
+	sums, err := crc.WalkDir(ctx, root, crcParams, opts...)
+	if err != nil {
+		return nil, err
+	}
+	ret := &Manifest{Parameters: crcParams, Entries: make([]Entry, 0, len(sums))}
+	for _, sum := range sums {
+		rel, err := filepath.Rel(root, sum.Path)
+		if err != nil {
+			return nil, err
+		}
+		ret.Entries = append(ret.Entries, Entry{Path: filepath.ToSlash(rel), CRC: sum.CRC})
+	}
+	sort.Slice(ret.Entries, func(i, j int) bool { return ret.Entries[i].Path < ret.Entries[j].Path })
+	return ret, nil
+}
+
+// Write writes the manifest to w in given format.
+func (m *Manifest) Write(w io.Writer, format Format) error {
+	bw := bufio.NewWriter(w)
+	switch format {
+	case SFV:
+		if *m.Parameters != *crc.CRC32 {
+			return fmt.Errorf("SFV format requires CRC32 algorithm")
+		}
+		for _, e := range m.Entries {
+			fmt.Fprintf(bw, "%s %08X\n", e.Path, e.CRC)
+		}
+	case Generic:
+		fmt.Fprintf(bw, "%s%s\n", genericHeader, algorithmName(m.Parameters))
+		digits := int(m.Parameters.Width+3) / 4
+		for _, e := range m.Entries {
+			fmt.Fprintf(bw, "%0*X  %s\n", digits, e.CRC, e.Path)
+		}
+	default:
+		return fmt.Errorf("unknown manifest format %d", format)
+	}
+	return bw.Flush()
+}
+
+// algorithmName returns name of the algorithm if known, or its RevEng style definition
+func algorithmName(crcParams *crc.Parameters) string {
+	if name, err := crc.GetParametersName(crcParams); err == nil {
+		return name
+	}
+	return crc.FormatParameters(crcParams)
+}
+
+// Parse reads a manifest in either format. Format is detected from the first line.
+func Parse(r io.Reader) (*Manifest, error) {
+	ret := &Manifest{Parameters: crc.CRC32}
+	format := SFV
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if lineNo == 1 && strings.HasPrefix(line, genericHeader) {
+			params, err := parseAlgorithm(strings.TrimPrefix(line, genericHeader))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			ret.Parameters = params
+			format = Generic
+			continue
+		}
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var path, checksum string
+		ok := false
+		if format == SFV {
+			if idx := strings.LastIndexByte(line, ' '); idx > 0 {
+				path, checksum, ok = line[:idx], line[idx+1:], true
+			}
+		} else {
+			checksum, path, ok = strings.Cut(line, "  ")
+		}
+		if !ok || path == "" {
+			return nil, fmt.Errorf("line %d: invalid entry %q", lineNo, line)
+		}
+		value, err := strconv.ParseUint(checksum, 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid checksum %q", lineNo, checksum)
+		}
+		ret.Entries = append(ret.Entries, Entry{Path: path, CRC: value})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// parseAlgorithm is the reverse of algorithmName
+func parseAlgorithm(s string) (*crc.Parameters, error) {
+	if strings.Contains(s, "=") {
+		return crc.ParseParameters(s)
+	}
+	return crc.GetParameters(s)
+}
+
+// Report lists differences found by Verify.
+type Report struct {
+	Missing []string // Missing lists files in manifest which do not exist
+	Changed []string // Changed lists files whose CRC differs from the one in manifest
+	Extra   []string // Extra lists files which are not in manifest
+}
+
+// OK reports whether no differences were found.
+func (r *Report) OK() bool {
+	return len(r.Missing) == 0 && len(r.Changed) == 0 && len(r.Extra) == 0
+}
+
+// Verify compares the manifest against the tree rooted at root.
+// All paths in the returned Report are sorted and use forward slashes.
+func Verify(ctx context.Context, root string, m *Manifest, opts ...crc.SumOption) (*Report, error) {
+	current, err := Create(ctx, root, m.Parameters, opts...)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]uint64, len(current.Entries))
+	for _, e := range current.Entries {
+		found[e.Path] = e.CRC
+	}
+
+	ret := &Report{}
+	listed := make(map[string]bool, len(m.Entries))
+	for _, e := range m.Entries {
+		listed[e.Path] = true
+		got, ok := found[e.Path]
+		if !ok {
+			ret.Missing = append(ret.Missing, e.Path)
+		} else if got != e.CRC {
+			ret.Changed = append(ret.Changed, e.Path)
+		}
+	}
+	for _, e := range current.Entries {
+		if !listed[e.Path] {
+			ret.Extra = append(ret.Extra, e.Path)
+		}
+	}
+	sort.Strings(ret.Missing)
+	sort.Strings(ret.Changed)
+	return ret, nil
+}
@@ -0,0 +1,137 @@
+package manifest_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ast-dd/crc"
+	"github.com/ast-dd/crc/manifest"
+)
+
+func writeTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestWrite(t *testing.T) {
+	root := writeTree(t, map[string]string{
+		"a.bin":          "123456789",
+		"sub dir/b.bin":  "12345678901234567890",
+		"sub dir/c/d.go": "Introduction on CRC calculations",
+	})
+
+	tests := []struct {
+		name      string
+		crcParams *crc.Parameters
+		format    manifest.Format
+		want      string
+		wantErr   bool
+	}{
+		{"SFV", crc.CRC32, manifest.SFV, "a.bin CBF43926\nsub dir/b.bin 906319F2\nsub dir/c/d.go 814F2B45\n", false},
+		{"SFV CRC16", crc.CRC16MODBUS, manifest.SFV, "", true},
+		{"generic", crc.CRC16MODBUS, manifest.Generic, "# crc manifest CRC16MODBUS\n4B37  a.bin\n8013  sub dir/b.bin\nE68B  sub dir/c/d.go\n", false},
+		{"generic custom", &crc.Parameters{Width: 12, Polynomial: 0x80f, ReflectOut: true}, manifest.Generic,
+			"# crc manifest width=12 poly=0x80f init=0x000 refin=false refout=true xorout=0x000 check=0xdaf\nDAF  a.bin\n1CB  sub dir/b.bin\n2A3  sub dir/c/d.go\n", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := manifest.Create(context.Background(), root, tt.crcParams)
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			var buf bytes.Buffer
+			err = m.Write(&buf, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Write() = %q, want %q", got, tt.want)
+			}
+
+			parsed, err := manifest.Parse(&buf)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if *parsed.Parameters != *tt.crcParams {
+				t.Errorf("Parse() parameters = %v, want %v", parsed.Parameters, tt.crcParams)
+			}
+			if !reflect.DeepEqual(parsed.Entries, m.Entries) {
+				t.Errorf("Parse() entries = %v, want %v", parsed.Entries, m.Entries)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	sfv := "; generated by some tool\r\n\r\nfile with spaces.txt cbf43926\r\nother 906319F2\r\n"
+	m, err := manifest.Parse(strings.NewReader(sfv))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	want := []manifest.Entry{{Path: "file with spaces.txt", CRC: 0xCBF43926}, {Path: "other", CRC: 0x906319F2}}
+	if m.Parameters != crc.CRC32 || !reflect.DeepEqual(m.Entries, want) {
+		t.Errorf("Parse() = %v %v, want %v", m.Parameters, m.Entries, want)
+	}
+
+	for _, invalid := range []string{
+		"nospace\n",
+		"file XYZ\n",
+		"# crc manifest UNKNOWN\n",
+		"# crc manifest CRC16MODBUS\n4B37 single-space\n",
+	} {
+		if _, err := manifest.Parse(strings.NewReader(invalid)); err == nil {
+			t.Errorf("Parse(%q) returned no error", invalid)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	root := writeTree(t, map[string]string{
+		"same":    "123456789",
+		"changed": "123456789",
+		"missing": "123456789",
+	})
+	m, err := manifest.Create(context.Background(), root, crc.CRC32C)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	report, err := manifest.Verify(context.Background(), root, m)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !report.OK() {
+		t.Errorf("Verify() of unmodified tree = %+v", report)
+	}
+
+	os.WriteFile(filepath.Join(root, "changed"), []byte("12345678"), 0o644)
+	os.Remove(filepath.Join(root, "missing"))
+	os.WriteFile(filepath.Join(root, "extra"), []byte("123456789"), 0o644)
+
+	report, err = manifest.Verify(context.Background(), root, m)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	want := &manifest.Report{Missing: []string{"missing"}, Changed: []string{"changed"}, Extra: []string{"extra"}}
+	if report.OK() || !reflect.DeepEqual(report, want) {
+		t.Errorf("Verify() = %+v, want %+v", report, want)
+	}
+}
@@ -2,7 +2,9 @@ package crc
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 )
 
 var parametersMap = map[string]*Parameters{
@@ -64,6 +66,13 @@ var parametersMap = map[string]*Parameters{
 	"CRC16OPENSAFETYB": CRC16OPENSAFETYB,
 	"CRC16PROFIBUS":    CRC16PROFIBUS,
 
+	"CRC15CAN":   CRC15CAN,
+	"CRC17CANFD": CRC17CANFD,
+	"CRC21CANFD": CRC21CANFD,
+	"CRC24BLE":   CRC24BLE,
+	"CRC5USB":    CRC5USB,
+	"CRC7MMC":    CRC7MMC,
+
 	"CRC32":       CRC32,
 	"IEEE":        IEEE,
 	"CRC32BZIP2":  CRC32BZIP2,
@@ -91,17 +100,34 @@ var parametersMap = map[string]*Parameters{
 func GetParameters(s string) (parameters *Parameters, err error) {
 	var ok bool
 	s = strings.ToUpper(s)
-	if parameters, ok = parametersMap[s]; !ok {
-		err = fmt.Errorf("unknown CRC type: %q", s)
+	if parameters, ok = parametersMap[s]; ok {
 		return
 	}
+	for name, p := range parametersMap {
+		if strings.EqualFold(name, s) {
+			parameters = p
+			return
+		}
+	}
+	err = fmt.Errorf("unknown CRC type: %q", s)
 	return
 }
 
-// GetParametersName returns the name for given CRC parameters by checking the pointer
+// ParametersNames returns sorted names of all known CRC parameters
+func ParametersNames() []string {
+	names := make([]string, 0, len(parametersMap))
+	for s := range parametersMap {
+		names = append(names, s)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetParametersName returns the name for given CRC parameters by checking the pointer.
+// If there are several names for the same parameters, the first one in sorted order is returned.
 func GetParametersName(parameters *Parameters) (name string, err error) {
-	for s, p := range parametersMap {
-		if p == parameters {
+	for _, s := range ParametersNames() {
+		if parametersMap[s] == parameters {
 			name = s
 			return
 		}
@@ -109,3 +135,22 @@ func GetParametersName(parameters *Parameters) (name string, err error) {
 	err = fmt.Errorf("parameters not from known list")
 	return
 }
+
+var (
+	knownNamesOnce sync.Once
+	knownNames     map[Parameters]string
+)
+
+// knownParametersName returns the name of known CRC parameters equal to crcParams by value.
+// If there are several names, the first one in sorted order is returned, like GetParametersName.
+func knownParametersName(crcParams Parameters) (string, bool) {
+	knownNamesOnce.Do(func() {
+		knownNames = make(map[Parameters]string, len(parametersMap))
+		names := ParametersNames()
+		for i := len(names) - 1; i >= 0; i-- {
+			knownNames[*parametersMap[names[i]]] = names[i]
+		}
+	})
+	name, ok := knownNames[crcParams]
+	return name, ok
+}
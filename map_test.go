@@ -19,6 +19,8 @@ func TestGetParameters(t *testing.T) {
 		{"CRC8SAEJ1850", "CRC8SAEJ1850", crc.CRC8SAEJ1850, false},
 		{"Crc8Saej1850", "Crc8Saej1850", crc.CRC8SAEJ1850, false},
 		{"CRC64ECMA", "CRC64ECMA", crc.CRC64ECMA, false},
+		{"Castagnoli", "Castagnoli", crc.Castagnoli, false},
+		{"koopman", "koopman", crc.Koopman, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -45,6 +47,7 @@ func TestGetParametersName(t *testing.T) {
 		{"unknown", &crc.Parameters{Width: 22}, "", true},
 		{"CRC8SAEJ1850", crc.CRC8SAEJ1850, "CRC8SAEJ1850", false},
 		{"CRC64ECMA", crc.CRC64ECMA, "CRC64ECMA", false},
+		{"alias", crc.X25, "CRC16X25", false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -59,3 +62,15 @@ func TestGetParametersName(t *testing.T) {
 		})
 	}
 }
+
+func TestParametersNames(t *testing.T) {
+	names := crc.ParametersNames()
+	for i, name := range names {
+		if i > 0 && names[i-1] >= name {
+			t.Errorf("ParametersNames() not sorted: %q before %q", names[i-1], name)
+		}
+		if _, err := crc.GetParameters(name); err != nil {
+			t.Errorf("GetParameters(%q) error = %v", name, err)
+		}
+	}
+}
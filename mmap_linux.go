@@ -0,0 +1,20 @@
+package crc
+
+import (
+	"context"
+	"os"
+	"syscall"
+)
+
+// sumMmap calculates CRC of the file by mapping it into memory
+func sumMmap(ctx context.Context, f *os.File, size int64, table *Table, c *sumConfig) (uint64, error) {
+	if size == 0 || int64(int(size)) != size {
+		return 0, errMmapNotSupported
+	}
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return 0, errMmapNotSupported
+	}
+	defer syscall.Munmap(data)
+	return sumBytes(ctx, data, table, c)
+}
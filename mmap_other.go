@@ -0,0 +1,13 @@
+//go:build !linux
+
+package crc
+
+import (
+	"context"
+	"os"
+)
+
+// sumMmap is not supported on this platform, files are always read
+func sumMmap(ctx context.Context, f *os.File, size int64, table *Table, c *sumConfig) (uint64, error) {
+	return 0, errMmapNotSupported
+}
@@ -0,0 +1,6 @@
+package modbus
+
+// BufferCap returns capacity of the frame buffer of f.
+func BufferCap(f *Framer) int {
+	return cap(f.buf)
+}
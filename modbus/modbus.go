@@ -0,0 +1,182 @@
+// Package modbus implements Modbus RTU framing protected by CRC-16/MODBUS.
+//
+// An RTU application data unit (ADU) consists of the slave address, function code,
+// data and CRC of all preceding bytes transmitted low byte first. Frames are delimited
+// by at least 3.5 character times of silence on the line, which Framer detects
+// from timestamps of received bytes.
+package modbus
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ast-dd/crc"
+)
+
+const (
+	// MinADUSize is the size of the shortest valid ADU: address, function code and CRC.
+	MinADUSize = 4
+	// MaxADUSize is the size of the longest valid ADU.
+	MaxADUSize = 256
+	// MaxDataSize is the maximal length of ADU data.
+	MaxDataSize = MaxADUSize - MinADUSize
+)
+
+var table = crc.NewTable(crc.CRC16MODBUS)
+
+// ADU is a Modbus RTU application data unit.
+type ADU struct {
+	Address  byte   // Address of the slave, 0 is broadcast
+	Function byte   // Function code
+	Data     []byte // Data of the request or response
+}
+
+// CRCError is returned by Decode when CRC of the frame does not match.
+type CRCError struct {
+	Received uint16 // Received CRC as transmitted in the frame
+	Computed uint16 // Computed CRC of the frame contents
+}
+
+func (e *CRCError) Error() string {
+	return fmt.Sprintf("modbus: CRC mismatch: received 0x%04X, computed 0x%04X", e.Received, e.Computed)
+}
+
+// LengthError is returned when a frame or ADU data is too short or too long.
+type LengthError struct {
+	Length int // Length of the offending frame or data
+	Min    int // Min is the minimal length allowed
+	Max    int // Max is the maximal length allowed
+}
+
+func (e *LengthError) Error() string {
+	return fmt.Sprintf("modbus: invalid length %d, must be between %d and %d", e.Length, e.Min, e.Max)
+}
+
+// BaudRateError is returned by CharacterTime and NewFramer for baud rates that are not positive.
+type BaudRateError struct {
+	BaudRate int // BaudRate is the offending baud rate
+}
+
+func (e *BaudRateError) Error() string {
+	return fmt.Sprintf("modbus: invalid baud rate %d, must be positive", e.BaudRate)
+}
+
+// ErrCharacterGap is returned by Framer for frames containing silence longer than 1.5 character times.
+var ErrCharacterGap = errors.New("modbus: silence within frame exceeds 1.5 character times")
+
+// Encode returns the RTU frame of adu including CRC.
+func Encode(adu ADU) ([]byte, error) {
+	return AppendADU(make([]byte, 0, MinADUSize+len(adu.Data)), adu)
+}
+
+// AppendADU appends the RTU frame of adu including CRC to dst and returns the extended slice.
+func AppendADU(dst []byte, adu ADU) ([]byte, error) {
+	if len(adu.Data) > MaxDataSize {
+		return dst, &LengthError{Length: len(adu.Data), Min: 0, Max: MaxDataSize}
+	}
+	start := len(dst)
+	dst = append(dst, adu.Address, adu.Function)
+	dst = append(dst, adu.Data...)
+	return table.AppendCRC(dst, dst[start:]), nil
+}
+
+// Decode parses an RTU frame and validates its CRC.
+// Data of the returned ADU refers to frame, it is not copied.
+func Decode(frame []byte) (ADU, error) {
+	if len(frame) < MinADUSize || len(frame) > MaxADUSize {
+		return ADU{}, &LengthError{Length: len(frame), Min: MinADUSize, Max: MaxADUSize}
+	}
+	payload := frame[:len(frame)-2]
+	received := uint16(frame[len(frame)-2]) | uint16(frame[len(frame)-1])<<8
+	if computed := table.CRC16(table.UpdateCrc(table.InitCrc(), payload)); computed != received {
+		return ADU{}, &CRCError{Received: received, Computed: computed}
+	}
+	return ADU{Address: frame[0], Function: frame[1], Data: payload[2:]}, nil
+}
+
+// CharacterTime returns the time needed to transmit one character of 11 bits at given baud rate.
+// It returns *BaudRateError if baudRate is not positive.
+func CharacterTime(baudRate int) (time.Duration, error) {
+	if baudRate <= 0 {
+		return 0, &BaudRateError{BaudRate: baudRate}
+	}
+	return time.Duration(11 * int64(time.Second) / int64(baudRate)), nil
+}
+
+// Framer splits a stream of received bytes into frames based on their timestamps.
+// A silence of at least 3.5 character times ends a frame, a silence of more than
+// 1.5 character times within a frame makes it invalid.
+//
+// Above 19200 baud, the specification recommends fixed timeouts of 750 µs and 1.75 ms,
+// which NewFramer uses.
+type Framer struct {
+	charGap  time.Duration // t1.5
+	frameGap time.Duration // t3.5
+
+	buf  []byte
+	last time.Time
+	err  error // reported instead of the current frame
+}
+
+// NewFramer creates a new Framer for given baud rate.
+// It returns *BaudRateError if baudRate is not positive.
+func NewFramer(baudRate int) (*Framer, error) {
+	if baudRate > 19200 {
+		return NewFramerWithTimeouts(750*time.Microsecond, 1750*time.Microsecond), nil
+	}
+	charTime, err := CharacterTime(baudRate)
+	if err != nil {
+		return nil, err
+	}
+	return NewFramerWithTimeouts(charTime*3/2, charTime*7/2), nil
+}
+
+// NewFramerWithTimeouts creates a new Framer using given inter-character and inter-frame timeouts.
+func NewFramerWithTimeouts(charGap, frameGap time.Duration) *Framer {
+	return &Framer{charGap: charGap, frameGap: frameGap, buf: make([]byte, 0, MaxADUSize)}
+}
+
+// Feed processes byte b received at given time. If b starts a new frame,
+// the previous frame is returned, or an error if it was invalid:
+// ErrCharacterGap or *LengthError for frames longer than MaxADUSize.
+func (f *Framer) Feed(b byte, at time.Time) ([]byte, error) {
+	var frame []byte
+	var err error
+	if len(f.buf) > 0 {
+		gap := at.Sub(f.last)
+		if gap >= f.frameGap {
+			frame, err = f.take()
+		} else if gap > f.charGap && f.err == nil {
+			f.err = ErrCharacterGap
+		}
+	}
+	if len(f.buf) >= MaxADUSize {
+		// the frame is invalid anyway, keep the buffer bounded on a line without silence
+		if f.err == nil {
+			f.err = &LengthError{Length: MaxADUSize + 1, Min: MinADUSize, Max: MaxADUSize}
+		}
+	} else {
+		f.buf = append(f.buf, b)
+	}
+	f.last = at
+	return frame, err
+}
+
+// Flush returns the pending frame if the line has been silent for at least 3.5 character times at given time.
+// It returns nil if there is no complete frame yet.
+func (f *Framer) Flush(now time.Time) ([]byte, error) {
+	if len(f.buf) == 0 || now.Sub(f.last) < f.frameGap {
+		return nil, nil
+	}
+	return f.take()
+}
+
+func (f *Framer) take() ([]byte, error) {
+	frame, err := f.buf, f.err
+	f.buf, f.err = make([]byte, 0, MaxADUSize), nil
+	if err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
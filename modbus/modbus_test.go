@@ -0,0 +1,152 @@
+package modbus_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ast-dd/crc/modbus"
+)
+
+// read holding registers 0-9 of slave 1
+var readHolding = []byte{0x01, 0x03, 0x00, 0x00, 0x00, 0x0A, 0xC5, 0xCD}
+
+func TestEncodeDecode(t *testing.T) {
+	adu := modbus.ADU{Address: 1, Function: 3, Data: []byte{0, 0, 0, 10}}
+	frame, err := modbus.Encode(adu)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if !bytes.Equal(frame, readHolding) {
+		t.Errorf("Encode() = % X, want % X", frame, readHolding)
+	}
+
+	frame, err = modbus.AppendADU([]byte{0xAA}, adu)
+	if err != nil || !bytes.Equal(frame[1:], readHolding) {
+		t.Errorf("AppendADU() = % X, %v", frame, err)
+	}
+
+	got, err := modbus.Decode(readHolding)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if got.Address != adu.Address || got.Function != adu.Function || !bytes.Equal(got.Data, adu.Data) {
+		t.Errorf("Decode() = %+v, want %+v", got, adu)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	corrupted := append([]byte{}, readHolding...)
+	corrupted[3] ^= 0x01
+	_, err := modbus.Decode(corrupted)
+	var crcErr *modbus.CRCError
+	if !errors.As(err, &crcErr) || crcErr.Received != 0xCDC5 {
+		t.Errorf("Decode(corrupted) error = %v", err)
+	}
+
+	var lengthErr *modbus.LengthError
+	for _, frame := range [][]byte{readHolding[:3], make([]byte, modbus.MaxADUSize+1)} {
+		if _, err = modbus.Decode(frame); !errors.As(err, &lengthErr) || lengthErr.Length != len(frame) {
+			t.Errorf("Decode(%d bytes) error = %v", len(frame), err)
+		}
+	}
+	if _, err = modbus.Encode(modbus.ADU{Data: make([]byte, modbus.MaxDataSize+1)}); !errors.As(err, &lengthErr) {
+		t.Errorf("Encode(too long) error = %v", err)
+	}
+	if _, err = modbus.Encode(modbus.ADU{Data: make([]byte, modbus.MaxDataSize)}); err != nil {
+		t.Errorf("Encode(longest) error = %v", err)
+	}
+}
+
+func TestFramer(t *testing.T) {
+	charTime, err := modbus.CharacterTime(9600)
+	if err != nil || charTime != 1145833*time.Nanosecond {
+		t.Errorf("CharacterTime(9600) = %v, %v", charTime, err)
+	}
+
+	f, err := modbus.NewFramer(9600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(0, 0)
+	var frames [][]byte
+	var errs []error
+	feed := func(data []byte, gap time.Duration) {
+		for _, b := range data {
+			now = now.Add(gap)
+			gap = charTime
+			frame, err := f.Feed(b, now)
+			if frame != nil {
+				frames = append(frames, frame)
+			}
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	feed(readHolding, 0)
+	feed(readHolding, 4*charTime)
+	// 2 character silence within frame
+	feed(readHolding[:3], 4*charTime)
+	feed(readHolding[3:], 3*charTime)
+	feed(readHolding, 4*charTime)
+
+	if frame, _ := f.Flush(now.Add(3 * charTime)); frame != nil {
+		t.Errorf("Flush() returned frame before 3.5 character silence")
+	}
+	frame, err := f.Flush(now.Add(4 * charTime))
+	if err != nil || frame == nil {
+		t.Fatalf("Flush() = % X, %v", frame, err)
+	}
+	frames = append(frames, frame)
+
+	if len(frames) != 3 || len(errs) != 1 || errs[0] != modbus.ErrCharacterGap {
+		t.Fatalf("got frames % X and errors %v", frames, errs)
+	}
+	for _, frame := range frames {
+		if !bytes.Equal(frame, readHolding) {
+			t.Errorf("frame % X, want % X", frame, readHolding)
+		}
+	}
+
+	// frames longer than MaxADUSize are rejected
+	feed(make([]byte, modbus.MaxADUSize+1), 4*charTime)
+	var lengthErr *modbus.LengthError
+	if _, err = f.Flush(now.Add(time.Second)); !errors.As(err, &lengthErr) {
+		t.Errorf("Flush() error = %v, want LengthError", err)
+	}
+
+	// buffer does not grow on a line without silence
+	stream := make([]byte, 1<<20)
+	feed(stream, 4*charTime)
+	if c := modbus.BufferCap(f); c > modbus.MaxADUSize {
+		t.Errorf("Feed() of %d bytes without silence grew buffer to %d bytes", len(stream), c)
+	}
+	if _, err = f.Flush(now.Add(time.Second)); !errors.As(err, &lengthErr) {
+		t.Errorf("Flush() error = %v, want LengthError", err)
+	}
+
+	// fixed timeouts above 19200 baud
+	if f, err = modbus.NewFramer(115200); err != nil {
+		t.Fatal(err)
+	}
+	f.Feed(1, now)
+	if frame, _ := f.Flush(now.Add(1700 * time.Microsecond)); frame != nil {
+		t.Errorf("Flush() returned frame before 1.75 ms")
+	}
+	if frame, _ := f.Flush(now.Add(1750 * time.Microsecond)); frame == nil {
+		t.Errorf("Flush() returned no frame after 1.75 ms")
+	}
+}
+
+func TestInvalidBaudRate(t *testing.T) {
+	var baudErr *modbus.BaudRateError
+	if _, err := modbus.CharacterTime(0); !errors.As(err, &baudErr) || baudErr.BaudRate != 0 {
+		t.Errorf("CharacterTime(0) error = %v, want BaudRateError", err)
+	}
+	if f, err := modbus.NewFramer(-9600); f != nil || !errors.As(err, &baudErr) || baudErr.BaudRate != -9600 {
+		t.Errorf("NewFramer(-9600) = %v, %v, want BaudRateError", f, err)
+	}
+}
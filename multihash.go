@@ -0,0 +1,81 @@
+package crc
+
+// multiHashBlockSize is the amount of data processed by all algorithms before moving on,
+// small enough to stay in L1 cache
+const multiHashBlockSize = 4 * 1024
+
+// MultiHash calculates CRC of the same data according to several algorithms in a single pass.
+// Data is processed in small blocks by every algorithm in turn, so it is read from memory only once.
+type MultiHash struct {
+	params []*Parameters
+	tables []*Table
+	names  []string
+	values []uint64
+}
+
+// MultiHashResult is the CRC calculated by MultiHash for one algorithm.
+type MultiHashResult struct {
+	Name       string      // Name of the algorithm in the registry, or its definition in RevEng format if not known
+	Parameters *Parameters // Parameters as passed to NewMultiHash
+	CRC        uint64      // CRC of the data written so far
+}
+
+// NewMultiHash creates a new MultiHash calculating CRC according to all parameters specified.
+func NewMultiHash(params ...*Parameters) *MultiHash {
+	h := &MultiHash{
+		params: params,
+		tables: make([]*Table, len(params)),
+		names:  make([]string, len(params)),
+		values: make([]uint64, len(params)),
+	}
+	for i, p := range params {
+		h.tables[i] = CachedTable(p)
+		if name, ok := knownParametersName(*p); ok {
+			h.names[i] = name
+		} else {
+			h.names[i] = FormatParameters(p)
+		}
+	}
+	h.Reset()
+	return h
+}
+
+// Reset resets all CRCs to their initial values.
+func (h *MultiHash) Reset() {
+	for i, table := range h.tables {
+		h.values[i] = table.InitCrc()
+	}
+}
+
+// Write updates all CRCs with data p. It never returns an error.
+// See io.Writer interface.
+func (h *MultiHash) Write(p []byte) (n int, err error) {
+	for done := 0; done < len(p); done += multiHashBlockSize {
+		block := p[done:]
+		if len(block) > multiHashBlockSize {
+			block = block[:multiHashBlockSize]
+		}
+		for i, table := range h.tables {
+			h.values[i] = table.UpdateCrc(h.values[i], block)
+		}
+	}
+	return len(p), nil
+}
+
+// Results returns CRCs of the data written so far in the order of parameters passed to NewMultiHash.
+func (h *MultiHash) Results() []MultiHashResult {
+	ret := make([]MultiHashResult, len(h.tables))
+	for i, table := range h.tables {
+		ret[i] = MultiHashResult{Name: h.names[i], Parameters: h.params[i], CRC: table.CRC(h.values[i])}
+	}
+	return ret
+}
+
+// CRCs returns CRCs of the data written so far keyed by algorithm name, see MultiHashResult.
+func (h *MultiHash) CRCs() map[string]uint64 {
+	ret := make(map[string]uint64, len(h.tables))
+	for i, table := range h.tables {
+		ret[h.names[i]] = table.CRC(h.values[i])
+	}
+	return ret
+}
@@ -0,0 +1,69 @@
+package crc_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/ast-dd/crc"
+)
+
+func TestMultiHash(t *testing.T) {
+	custom := &crc.Parameters{Width: 12, Polynomial: 0x80f, ReflectOut: true}
+	params := []*crc.Parameters{crc.CRC16MODBUS, crc.CRC32, crc.CRC64ECMA, crc.CRC8, custom}
+	h := crc.NewMultiHash(params...)
+
+	data := strings.Repeat(byteTestStrings[3], 300)
+	if _, err := io.Copy(h, strings.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	results := h.Results()
+	crcs := h.CRCs()
+	if len(results) != len(params) || len(crcs) != len(params) {
+		t.Fatalf("got %d results and %d CRCs, want %d", len(results), len(crcs), len(params))
+	}
+	for i, p := range params {
+		want := crc.CalculateCRC(p, []byte(data))
+		if results[i].Parameters != p || results[i].CRC != want {
+			t.Errorf("result %d = %+v, want CRC 0x%X", i, results[i], want)
+		}
+		if crcs[results[i].Name] != want {
+			t.Errorf("CRCs()[%q] = 0x%X, want 0x%X", results[i].Name, crcs[results[i].Name], want)
+		}
+	}
+	if results[0].Name != "CRC16MODBUS" || results[4].Name != crc.FormatParameters(custom) {
+		t.Errorf("unexpected names %q and %q", results[0].Name, results[4].Name)
+	}
+
+	// registry names are found by value
+	copied := *crc.CRC32C
+	parsed, err := crc.ParseParameters(crc.FormatParameters(crc.CRC16X25))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results = crc.NewMultiHash(&copied, parsed).Results()
+	if results[0].Name != "CRC32C" || results[1].Name != "CRC16X25" {
+		t.Errorf("names of copied and parsed parameters = %q and %q, want CRC32C and CRC16X25", results[0].Name, results[1].Name)
+	}
+
+	h.Reset()
+	h.Write([]byte("123456789"))
+	if got := h.CRCs()["CRC32"]; got != 0xCBF43926 {
+		t.Errorf("after Reset CRC32 = 0x%X, want 0xCBF43926", got)
+	}
+}
+
+func BenchmarkMultiHash(b *testing.B) {
+	var params []*crc.Parameters
+	for _, name := range crc.ParametersNames() {
+		p, _ := crc.GetParameters(name)
+		params = append(params, p)
+	}
+	h := crc.NewMultiHash(params...)
+	data := make([]byte, 64*1024)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h.Write(data)
+	}
+}
@@ -0,0 +1,33 @@
+package crc
+
+import "fmt"
+
+// Patch returns the CRC of data after replacing oldBytes at given offset with newBytes,
+// where oldCRC is the CRC of the original data of totalLen bytes. The data itself is not needed:
+// the work is proportional to the size of the patched region plus O(log totalLen).
+//
+// CRC is linear, so the CRC of the patched data differs from oldCRC by the CRC (with zero Init
+// and FinalXor) of a message which is zero except for oldBytes xor newBytes at offset.
+func (t *Table) Patch(oldCRC uint64, totalLen int64, offset int64, oldBytes, newBytes []byte) uint64 {
+	if len(oldBytes) != len(newBytes) {
+		panic(fmt.Sprintf("crc: patch replaces %d bytes with %d bytes", len(oldBytes), len(newBytes)))
+	}
+	if offset < 0 || offset+int64(len(oldBytes)) > totalLen {
+		panic(fmt.Sprintf("crc: patch of %d bytes at offset %d out of %d bytes", len(oldBytes), offset, totalLen))
+	}
+
+	// leading zeros do not change zero register, so only the delta and trailing zeros matter
+	var curValue uint64
+	var buf [64]byte
+	for i := 0; i < len(oldBytes); i += len(buf) {
+		n := copy(buf[:], oldBytes[i:])
+		for j := 0; j < n; j++ {
+			buf[j] ^= newBytes[i+j]
+		}
+		curValue = t.UpdateCrc(curValue, buf[:n]) & t.mask
+	}
+	curValue = t.UpdateZeros(curValue, totalLen-offset-int64(len(oldBytes)))
+
+	// CRC() is affine, so CRC(0) cancels out FinalXor
+	return (oldCRC ^ t.CRC(curValue) ^ t.CRC(0)) & t.mask
+}
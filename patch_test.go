@@ -0,0 +1,67 @@
+package crc_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ast-dd/crc"
+)
+
+func TestTablePatch(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 5000)
+	rnd.Read(data)
+
+	params := []*crc.Parameters{
+		{Width: 5, Polynomial: 0x15, Init: 0x1F, ReflectOut: true, FinalXor: 0x0A},
+		{Width: 12, Polynomial: 0x80f, ReflectOut: true},
+	}
+	for _, name := range crc.ParametersNames() {
+		p, _ := crc.GetParameters(name)
+		params = append(params, p)
+	}
+
+	for _, p := range params {
+		for _, engine := range []crc.Engine{crc.ByteTableEngine, crc.BitwiseEngine} {
+			table := crc.NewTableWithEngine(p, engine)
+			for _, patch := range []struct{ offset, length int }{{0, 4}, {100, 1}, {1000, 130}, {4990, 10}, {2500, 0}} {
+				newBytes := make([]byte, patch.length)
+				rnd.Read(newBytes)
+				patched := append([]byte{}, data...)
+				copy(patched[patch.offset:], newBytes)
+
+				oldBytes := data[patch.offset : patch.offset+patch.length]
+				got := table.Patch(table.CalculateCRC(data), int64(len(data)), int64(patch.offset), oldBytes, newBytes)
+				if want := table.CalculateCRC(patched); got != want {
+					t.Errorf("%+v %v Patch(offset %d, length %d) = 0x%X, want 0x%X", *p, engine, patch.offset, patch.length, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestTablePatchPanics(t *testing.T) {
+	table := crc.NewTable(crc.CRC32)
+	for _, f := range []func(){
+		func() { table.Patch(0, 10, 0, []byte{1}, []byte{1, 2}) },
+		func() { table.Patch(0, 10, 9, []byte{1, 2}, []byte{3, 4}) },
+		func() { table.Patch(0, 10, -1, []byte{1}, []byte{2}) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("Patch() did not panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func BenchmarkTablePatch(b *testing.B) {
+	table := crc.NewTable(crc.CRC32)
+	oldBytes, newBytes := []byte("abcd"), []byte("efgh")
+	for i := 0; i < b.N; i++ {
+		table.Patch(0x12345678, 1<<30, 1<<20, oldBytes, newBytes)
+	}
+}
@@ -0,0 +1,124 @@
+package crc
+
+import (
+	"fmt"
+	"io"
+)
+
+// ErrChecksumMismatch is returned by VerifyingReader when the CRC calculated
+// over the payload does not match the checksum stored in the trailer.
+type ErrChecksumMismatch struct {
+	Expected uint64 // Expected is the checksum read from the trailer
+	Computed uint64 // Computed is the checksum calculated over the payload
+}
+
+func (e *ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf("crc mismatch: expected 0x%X, computed 0x%X", e.Expected, e.Computed)
+}
+
+// verifyingReaderBufSize is the size of chunks VerifyingReader reads from underlying reader
+const verifyingReaderBufSize = 4096
+
+// VerifyingReader passes through payload of the data stream which ends with
+// a CRC trailer. The trailer itself is held back and checked against the CRC
+// of the payload once the underlying reader is exhausted.
+type VerifyingReader struct {
+	r          io.Reader
+	hash       *Hash
+	trailerLen int
+	order      ByteOrder
+	buf        []byte
+	off        int
+	srcErr     error
+	err        error
+}
+
+// NewVerifyingReader creates a new VerifyingReader reading from r. Last trailerLen bytes
+// of the stream are interpreted as the CRC of the preceding data, serialized using given byte order.
+// Instead of io.EOF, Read returns *ErrChecksumMismatch at the end of the stream if the CRC does not match.
+func NewVerifyingReader(r io.Reader, crcParams *Parameters, trailerLen int, order ByteOrder) *VerifyingReader {
+	ret := &VerifyingReader{
+		r:          r,
+		hash:       NewHash(crcParams),
+		trailerLen: trailerLen,
+		order:      order,
+	}
+	ret.err = checkTrailerLen(crcParams, trailerLen)
+	return ret
+}
+
+// Read implements io.Reader interface.
+func (v *VerifyingReader) Read(p []byte) (n int, err error) {
+	if v.err != nil {
+		return 0, v.err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	for len(v.buf)-v.off <= v.trailerLen && v.srcErr == nil {
+		if v.off > 0 {
+			v.buf = v.buf[:copy(v.buf, v.buf[v.off:])]
+			v.off = 0
+		}
+		if v.buf == nil {
+			v.buf = make([]byte, 0, v.trailerLen+verifyingReaderBufSize)
+		}
+		var m int
+		m, v.srcErr = v.r.Read(v.buf[len(v.buf):cap(v.buf)])
+		v.buf = v.buf[:len(v.buf)+m]
+	}
+
+	if avail := len(v.buf) - v.off - v.trailerLen; avail > 0 {
+		if avail > len(p) {
+			avail = len(p)
+		}
+		n = copy(p, v.buf[v.off:v.off+avail])
+		v.off += n
+		v.hash.Update(p[:n])
+		return n, nil
+	}
+
+	if v.srcErr != io.EOF {
+		v.err = v.srcErr
+		return 0, v.err
+	}
+	if len(v.buf)-v.off < v.trailerLen {
+		v.err = io.ErrUnexpectedEOF
+		return 0, v.err
+	}
+
+	expected := getChecksum(v.buf[v.off:], v.order)
+	computed := v.hash.CRC()
+	if expected != computed {
+		v.err = &ErrChecksumMismatch{Expected: expected, Computed: computed}
+	} else {
+		v.err = io.EOF
+	}
+	return 0, v.err
+}
+
+// TeeReader passes through all data read from underlying reader
+// and keeps the running CRC of everything read so far.
+type TeeReader struct {
+	r    io.Reader
+	hash *Hash
+}
+
+// NewTeeReader creates a new TeeReader reading from r and calculating CRC
+// according to parameters specified.
+func NewTeeReader(r io.Reader, crcParams *Parameters) *TeeReader {
+	return &TeeReader{r: r, hash: NewHash(crcParams)}
+}
+
+// Read implements io.Reader interface.
+func (t *TeeReader) Read(p []byte) (n int, err error) {
+	n, err = t.r.Read(p)
+	t.hash.Update(p[:n])
+	return
+}
+
+// Hash returns the Hash holding the CRC of the data read so far.
+func (t *TeeReader) Hash() *Hash {
+	return t.hash
+}
@@ -0,0 +1,72 @@
+package crc_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/ast-dd/crc"
+)
+
+func TestVerifyingReader(t *testing.T) {
+	for _, tt := range byteTests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, testString := range byteTestStrings {
+				payload := []byte(testString)
+				trailer := tt.wantBytes[i]
+				framed := append(append([]byte{}, payload...), trailer...)
+
+				// correct, read one byte at a time
+				r := crc.NewVerifyingReader(iotest.OneByteReader(bytes.NewReader(framed)), tt.crcParams, len(trailer), crc.LittleEndian)
+				got, err := io.ReadAll(r)
+				if err != nil {
+					t.Errorf("ReadAll(%q) error = %v", testString, err)
+				}
+				if !bytes.Equal(got, payload) {
+					t.Errorf("ReadAll(%q) = %q", testString, got)
+				}
+
+				// correct, big endian trailer
+				reversed := make([]byte, len(trailer))
+				for j := range trailer {
+					reversed[len(trailer)-1-j] = trailer[j]
+				}
+				framedBE := append(append([]byte{}, payload...), reversed...)
+				r = crc.NewVerifyingReader(bytes.NewReader(framedBE), tt.crcParams, len(trailer), crc.BigEndian)
+				if err = iotest.TestReader(r, payload); err != nil && !errors.Is(err, io.EOF) {
+					t.Errorf("TestReader(%q) error = %v", testString, err)
+				}
+
+				// corrupted payload
+				corrupted := append([]byte{}, framed...)
+				corrupted[0] ^= 0x01
+				r = crc.NewVerifyingReader(bytes.NewReader(corrupted), tt.crcParams, len(trailer), crc.LittleEndian)
+				_, err = io.ReadAll(r)
+				var mismatch *crc.ErrChecksumMismatch
+				if !errors.As(err, &mismatch) {
+					t.Fatalf("ReadAll(%q) error = %v, want ErrChecksumMismatch", testString, err)
+				}
+				if want := crc.CalculateCRC(tt.crcParams, corrupted[:len(payload)]); mismatch.Computed != want {
+					t.Errorf("ErrChecksumMismatch.Computed = 0x%X, want 0x%X", mismatch.Computed, want)
+				}
+				if want := crc.CalculateCRC(tt.crcParams, payload); mismatch.Expected != want {
+					t.Errorf("ErrChecksumMismatch.Expected = 0x%X, want 0x%X", mismatch.Expected, want)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyingReaderShortStream(t *testing.T) {
+	r := crc.NewVerifyingReader(bytes.NewReader([]byte{0x01}), crc.CRC16MODBUS, 2, crc.LittleEndian)
+	if _, err := io.ReadAll(r); err != io.ErrUnexpectedEOF {
+		t.Errorf("ReadAll() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+
+	r = crc.NewVerifyingReader(bytes.NewReader([]byte{0x01, 0x02}), crc.CRC32, 2, crc.LittleEndian)
+	if _, err := io.ReadAll(r); err == nil {
+		t.Errorf("ReadAll() accepted trailer shorter than CRC width")
+	}
+}
@@ -0,0 +1,76 @@
+package crc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseParameters parses CRC parameters given in the format used by the CRC RevEng catalogue,
+// e.g. `width=16 poly=0x1021 init=0xffff refin=true refout=true xorout=0xffff check=0x906e name="X-25"`.
+// Fields width and poly are mandatory. If check is present, it is verified against
+// the CRC of "123456789" calculated using parsed parameters. Fields residue and name are ignored.
+func ParseParameters(s string) (*Parameters, error) {
+	ret := &Parameters{}
+	var (
+		hasWidth, hasPoly, hasCheck bool
+		check                       uint64
+	)
+	for _, field := range strings.Fields(s) {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid field %q", field)
+		}
+		var err error
+		switch strings.ToLower(key) {
+		case "width":
+			var w uint64
+			w, err = strconv.ParseUint(value, 0, 8)
+			ret.Width = uint(w)
+			hasWidth = true
+		case "poly":
+			ret.Polynomial, err = strconv.ParseUint(value, 0, 64)
+			hasPoly = true
+		case "init":
+			ret.Init, err = strconv.ParseUint(value, 0, 64)
+		case "refin":
+			ret.ReflectIn, err = strconv.ParseBool(value)
+		case "refout":
+			ret.ReflectOut, err = strconv.ParseBool(value)
+		case "xorout":
+			ret.FinalXor, err = strconv.ParseUint(value, 0, 64)
+		case "check":
+			check, err = strconv.ParseUint(value, 0, 64)
+			hasCheck = true
+		case "residue", "name":
+		default:
+			return nil, fmt.Errorf("unknown field %q", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of field %q: %w", key, err)
+		}
+	}
+
+	if !hasWidth || !hasPoly {
+		return nil, fmt.Errorf("fields width and poly are mandatory")
+	}
+	if ret.Width == 0 || ret.Width > 64 {
+		return nil, fmt.Errorf("unsupported width %d", ret.Width)
+	}
+	if hasCheck {
+		if got := CalculateCRC(ret, []byte("123456789")); got != check {
+			return nil, fmt.Errorf("check value mismatch: calculated 0x%X, expected 0x%X", got, check)
+		}
+	}
+	return ret, nil
+}
+
+// FormatParameters returns CRC parameters in the format used by the CRC RevEng catalogue.
+// The result can be parsed back using ParseParameters.
+func FormatParameters(crcParams *Parameters) string {
+	digits := int(crcParams.Width+3) / 4
+	check := CalculateCRC(crcParams, []byte("123456789"))
+	return fmt.Sprintf("width=%d poly=0x%0*x init=0x%0*x refin=%t refout=%t xorout=0x%0*x check=0x%0*x",
+		crcParams.Width, digits, crcParams.Polynomial, digits, crcParams.Init,
+		crcParams.ReflectIn, crcParams.ReflectOut, digits, crcParams.FinalXor, digits, check)
+}
@@ -0,0 +1,57 @@
+package crc_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ast-dd/crc"
+)
+
+func TestParseParameters(t *testing.T) {
+	tests := []struct {
+		name           string
+		s              string
+		wantParameters *crc.Parameters
+		wantErr        bool
+	}{
+		{"empty", "", nil, true},
+		{"X-25", `width=16 poly=0x1021 init=0xffff refin=true refout=true xorout=0xffff check=0x906e residue=0xf0b8 name="CRC-16/IBM-SDLC"`, crc.CRC16X25, false},
+		{"CRC-64/XZ", "width=64 poly=0x42f0e1eba9ea3693 init=0xffffffffffffffff refin=true refout=true xorout=0xffffffffffffffff check=0x995dc9bbdf1939fa", crc.CRC64ECMA, false},
+		{"CRC-3/GSM", "width=3 poly=0x3 xorout=0x7 check=0x4", &crc.Parameters{Width: 3, Polynomial: 0x03, FinalXor: 0x7}, false},
+		{"missing poly", "width=16 init=0xffff", nil, true},
+		{"bad check", "width=16 poly=0x1021 check=0x1234", nil, true},
+		{"bad width", "width=65 poly=0x1", nil, true},
+		{"bad bool", "width=8 poly=0x07 refin=maybe", nil, true},
+		{"unknown field", "width=8 poly=0x07 foo=bar", nil, true},
+		{"no value", "width=8 poly", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotParameters, err := crc.ParseParameters(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseParameters() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotParameters, tt.wantParameters) {
+				t.Errorf("ParseParameters() gotParameters = %v, want %v", gotParameters, tt.wantParameters)
+			}
+		})
+	}
+}
+
+func TestFormatParameters(t *testing.T) {
+	if got, want := crc.FormatParameters(crc.CRC16X25), "width=16 poly=0x1021 init=0xffff refin=true refout=true xorout=0xffff check=0x906e"; got != want {
+		t.Errorf("FormatParameters() = %q, want %q", got, want)
+	}
+	for _, name := range crc.ParametersNames() {
+		params, _ := crc.GetParameters(name)
+		parsed, err := crc.ParseParameters(crc.FormatParameters(params))
+		if err != nil {
+			t.Errorf("ParseParameters(FormatParameters(%s)) error = %v", name, err)
+			continue
+		}
+		if *parsed != *params {
+			t.Errorf("ParseParameters(FormatParameters(%s)) = %v, want %v", name, parsed, params)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package crc
+
+import "fmt"
+
+// RollingHash calculates CRC over a fixed size window sliding over a stream of data.
+// Moving the window by one byte takes constant time regardless of the window size.
+//
+// The update of a CRC register by a byte is linear, so the contribution of the byte
+// leaving the window can be precomputed for each of its 256 possible values and cancelled
+// out by a single xor.
+type RollingHash struct {
+	table    *Table
+	window   int
+	outTable []uint64
+	zeroCrc  uint64 // register after processing window of zero bytes
+	curValue uint64
+}
+
+// NewRollingHash creates a new RollingHash for windows of given size according to parameters specified.
+func NewRollingHash(crcParams *Parameters, window int) *RollingHash {
+	return NewRollingHashWithTable(NewTable(crcParams), window)
+}
+
+// NewRollingHashWithTable creates a new RollingHash for windows of given size
+// using a Table instance created elsewhere.
+func NewRollingHashWithTable(table *Table, window int) *RollingHash {
+	if window <= 0 {
+		panic(fmt.Sprintf("crc: invalid rolling window size %d", window))
+	}
+	ret := &RollingHash{table: table, window: window}
+	mask := table.mask
+	width := table.crcParams.Width
+	zero := [1]byte{0}
+	shift := func(v uint64) uint64 {
+		return table.UpdateCrc(v, zero[:]) & mask
+	}
+
+	// shifted[k] is register with only bit k set shifted by window bytes
+	shifted := make([]uint64, width)
+	for k := range shifted {
+		v := uint64(1) << uint(k)
+		for i := 0; i < window; i++ {
+			v = shift(v)
+		}
+		shifted[k] = v
+	}
+	shiftWindow := func(v uint64) uint64 {
+		var ret uint64
+		for k := uint(0); k < width; k++ {
+			if v&(uint64(1)<<k) != 0 {
+				ret ^= shifted[k]
+			}
+		}
+		return ret
+	}
+
+	// Processing byte b from register r gives L(r) ^ T[b], with L being linear.
+	// After window more bytes, contribution of b is L^window(T[b]), and the initial
+	// value contributes L^window(init) instead of L^(window+1)(init).
+	init := table.InitCrc() & mask
+	initFix := shiftWindow(init) ^ shiftWindow(shift(init))
+	ret.zeroCrc = shiftWindow(init)
+	ret.outTable = make([]uint64, 256)
+	for b := range ret.outTable {
+		single := [1]byte{byte(b)}
+		ret.outTable[b] = shiftWindow(table.UpdateCrc(0, single[:])&mask) ^ initFix
+	}
+	ret.Reset()
+	return ret
+}
+
+// Reset sets the window to all zero bytes.
+func (r *RollingHash) Reset() {
+	r.curValue = r.zeroCrc
+}
+
+// Fill sets the window to given data, which must be exactly Window() bytes long.
+func (r *RollingHash) Fill(window []byte) {
+	if len(window) != r.window {
+		panic(fmt.Sprintf("crc: rolling window of %d bytes filled with %d bytes", r.window, len(window)))
+	}
+	r.curValue = r.table.UpdateCrc(r.table.InitCrc(), window) & r.table.mask
+}
+
+// Roll moves the window by one byte: in enters the window and out, which must be
+// the oldest byte of the window, leaves it. While filling the window after Reset,
+// out is the zero byte.
+func (r *RollingHash) Roll(in, out byte) {
+	b := [1]byte{in}
+	r.curValue = (r.table.UpdateCrc(r.curValue, b[:]) & r.table.mask) ^ r.outTable[out]
+}
+
+// CRC returns CRC of the current window.
+func (r *RollingHash) CRC() uint64 {
+	return r.table.CRC(r.curValue)
+}
+
+// Window returns the size of the window in bytes.
+func (r *RollingHash) Window() int {
+	return r.window
+}
+
+// Table used by this RollingHash under the hood
+func (r *RollingHash) Table() *Table {
+	return r.table
+}
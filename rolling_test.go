@@ -0,0 +1,78 @@
+package crc_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ast-dd/crc"
+)
+
+func TestRollingHash(t *testing.T) {
+	data := make([]byte, 300)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	params := []*crc.Parameters{
+		crc.CRC8, crc.CRC8DARC, crc.CRC16MODBUS, crc.CRC16CCITTFALSE, crc.CRC16DECTR,
+		crc.CRC32, crc.CRC32BZIP2, crc.CRC32C, crc.CRC64ECMA,
+		{Width: 3, Polynomial: 0x03, FinalXor: 0x7},
+		{Width: 5, Polynomial: 0x15, Init: 0x1F, ReflectIn: true, ReflectOut: true},
+		{Width: 12, Polynomial: 0x80f, Init: 0x123, ReflectOut: true},
+	}
+	for _, p := range params {
+		for _, window := range []int{1, 4, 16, 48} {
+			for _, engine := range []crc.Engine{crc.ByteTableEngine, crc.NibbleTableEngine} {
+				r := crc.NewRollingHashWithTable(crc.NewTableWithEngine(p, engine), window)
+				if r.Window() != window {
+					t.Fatalf("Window() = %d, want %d", r.Window(), window)
+				}
+
+				// filling window from zeros
+				zeros := make([]byte, window)
+				for i := 0; i < len(data); i++ {
+					var out byte
+					if i >= window {
+						out = data[i-window]
+					}
+					r.Roll(data[i], out)
+
+					start := i + 1 - window
+					var want uint64
+					if start >= 0 {
+						want = crc.CalculateCRC(p, data[start:i+1])
+					} else {
+						want = crc.CalculateCRC(p, append(zeros[:-start:-start], data[:i+1]...))
+					}
+					if got := r.CRC(); got != want {
+						t.Fatalf("%s window %d %v: CRC() at %d = 0x%X, want 0x%X", crc.FormatParameters(p), window, engine, i, got, want)
+					}
+				}
+
+				// explicit fill
+				r.Fill(data[:window])
+				if got, want := r.CRC(), crc.CalculateCRC(p, data[:window]); got != want {
+					t.Errorf("%s window %d: CRC() after Fill = 0x%X, want 0x%X", crc.FormatParameters(p), window, got, want)
+				}
+				r.Roll(data[window], data[0])
+				if got, want := r.CRC(), crc.CalculateCRC(p, data[1:window+1]); got != want {
+					t.Errorf("%s window %d: CRC() after Fill and Roll = 0x%X, want 0x%X", crc.FormatParameters(p), window, got, want)
+				}
+
+				r.Reset()
+				if got, want := r.CRC(), crc.CalculateCRC(p, zeros); got != want {
+					t.Errorf("%s window %d: CRC() after Reset = 0x%X, want 0x%X", crc.FormatParameters(p), window, got, want)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkRollingHash(b *testing.B) {
+	data := []byte(byteTestStrings[3])
+	r := crc.NewRollingHash(crc.CRC32, 64)
+	b.SetBytes(int64(len(data) - 64))
+	for i := 0; i < b.N; i++ {
+		for j := 64; j < len(data); j++ {
+			r.Roll(data[j], data[j-64])
+		}
+	}
+}
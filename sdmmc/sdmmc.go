@@ -0,0 +1,168 @@
+// Package sdmmc implements CRC protection of SD and MMC command tokens and data blocks.
+//
+// Command and response tokens are 48 bits long: start bit 0, transmission bit (1 for commands sent by host),
+// 6 bit command index, 32 bit argument, CRC-7/MMC of the preceding 40 bits and end bit 1.
+// Data blocks are protected by CRC-16/XMODEM calculated separately for every DAT line,
+// so on 4 bit wide bus each line carries its own CRC over its share of the interleaved data.
+package sdmmc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ast-dd/crc"
+)
+
+const (
+	// TokenSize is the size of command and response tokens.
+	TokenSize = 6
+	// DataCRC4Size is the size of CRCs following a data block on 4 bit wide bus.
+	DataCRC4Size = 8
+)
+
+var (
+	table7  = crc.NewTable(crc.CRC7MMC)
+	table16 = crc.NewTable(crc.CRC16XMODEM)
+)
+
+var (
+	// ErrCRC is returned for tokens with CRC mismatch.
+	ErrCRC = errors.New("sdmmc: CRC error")
+	// ErrFormat is returned for tokens with invalid length, start, transmission or end bit.
+	ErrFormat = errors.New("sdmmc: invalid token format")
+)
+
+// CRC7 calculates CRC-7/MMC of data, usually the first 5 bytes of a token.
+func CRC7(data []byte) uint8 {
+	return uint8(table7.CalculateCRC(data))
+}
+
+// Command is the contents of a command token, or of a response token with the same layout, like R1, R6 or R7.
+type Command struct {
+	Index    uint8  // Index of the command, 6 bits
+	Argument uint32 // Argument of the command or card status of the response
+}
+
+// appendToken appends token with given transmission bit
+func appendToken(dst []byte, transmission byte, c Command) ([]byte, error) {
+	if c.Index > 0x3F {
+		return dst, fmt.Errorf("sdmmc: invalid command index %d", c.Index)
+	}
+	start := len(dst)
+	dst = append(dst, transmission<<6|c.Index, byte(c.Argument>>24), byte(c.Argument>>16), byte(c.Argument>>8), byte(c.Argument))
+	return append(dst, CRC7(dst[start:])<<1|1), nil
+}
+
+// decodeToken parses token with given transmission bit
+func decodeToken(token []byte, transmission byte) (Command, error) {
+	if len(token) != TokenSize || token[0]>>6 != transmission || token[5]&1 != 1 {
+		return Command{}, ErrFormat
+	}
+	if CRC7(token[:5]) != token[5]>>1 {
+		return Command{}, ErrCRC
+	}
+	return Command{
+		Index:    token[0] & 0x3F,
+		Argument: uint32(token[1])<<24 | uint32(token[2])<<16 | uint32(token[3])<<8 | uint32(token[4]),
+	}, nil
+}
+
+// AppendCommand appends the command token sent by host to dst and returns the extended slice.
+func AppendCommand(dst []byte, c Command) ([]byte, error) {
+	return appendToken(dst, 1, c)
+}
+
+// DecodeCommand parses a command token sent by host, verifying its format and CRC.
+func DecodeCommand(token []byte) (Command, error) {
+	return decodeToken(token, 1)
+}
+
+// AppendResponse appends the 48 bit response token sent by card to dst and returns the extended slice.
+func AppendResponse(dst []byte, c Command) ([]byte, error) {
+	return appendToken(dst, 0, c)
+}
+
+// DecodeResponse parses a 48 bit response token sent by card, verifying its format and CRC.
+// It must not be used for R3 responses, which carry no CRC.
+func DecodeResponse(token []byte) (Command, error) {
+	return decodeToken(token, 0)
+}
+
+// DataCRC1 calculates CRC-16 of a data block transferred over 1 bit wide bus.
+func DataCRC1(data []byte) uint16 {
+	return uint16(table16.CalculateCRC(data))
+}
+
+// DataCRC4 calculates CRC-16 of every DAT line of a data block transferred over 4 bit wide bus,
+// element i being CRC of DATi. Bytes are transferred high nibble first, bit 3 of a nibble on DAT3.
+func DataCRC4(data []byte) [4]uint16 {
+	var curValues [4]uint64
+	for i := range curValues {
+		curValues[i] = table16.InitCrc()
+	}
+
+	// de-interleave 4 bytes at once into a byte for each line
+	var lines [4][64]byte
+	for len(data) > 0 {
+		n := len(data) / 4
+		if n > len(lines[0]) {
+			n = len(lines[0])
+		}
+		for j := 0; j < n; j++ {
+			for i := range lines {
+				lines[i][j] = lineBits(data[4*j:4*j+4], uint(i))
+			}
+		}
+		for i := range lines {
+			curValues[i] = table16.UpdateCrc(curValues[i], lines[i][:n])
+		}
+		data = data[4*n:]
+
+		if n == 0 {
+			// remaining 1-3 bytes carry 2 bits per line each
+			for i := range lines {
+				v := lineBits(data, uint(i))
+				curValues[i] = table16.UpdateBits(curValues[i], uint64(v)>>(8-2*len(data)), uint(2*len(data)))
+			}
+			break
+		}
+	}
+
+	var ret [4]uint16
+	for i, v := range curValues {
+		ret[i] = uint16(table16.CRC(v))
+	}
+	return ret
+}
+
+// lineBits returns bits of up to 4 bytes transferred on given DAT line, the first one as the most significant bit
+func lineBits(p []byte, line uint) byte {
+	var v byte
+	for k, b := range p {
+		v |= (b>>(line+4)&1)<<(7-2*uint(k)) | (b>>line&1)<<(6-2*uint(k))
+	}
+	return v
+}
+
+// AppendDataCRC4 appends CRCs of data calculated by DataCRC4 to dst as transferred on 4 bit wide bus
+// and returns the extended slice. Each DAT line transfers its CRC most significant bit first.
+func AppendDataCRC4(dst, data []byte) []byte {
+	crcs := DataCRC4(data)
+	for bit := 15; bit > 0; bit -= 2 {
+		var b byte
+		for i, v := range crcs {
+			b |= byte(v>>uint(bit)&1)<<(uint(i)+4) | byte(v>>uint(bit-1)&1)<<uint(i)
+		}
+		dst = append(dst, b)
+	}
+	return dst
+}
+
+// CheckDataCRC4 reports whether block, data followed by CRCs as appended by AppendDataCRC4, has valid CRCs.
+func CheckDataCRC4(block []byte) bool {
+	if len(block) < DataCRC4Size {
+		return false
+	}
+	// CRC of every line including its own CRC is zero
+	return DataCRC4(block) == [4]uint16{}
+}
@@ -0,0 +1,114 @@
+package sdmmc_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ast-dd/crc/sdmmc"
+)
+
+func TestCommand(t *testing.T) {
+	tests := []struct {
+		cmd   sdmmc.Command
+		token []byte
+	}{
+		{sdmmc.Command{Index: 0, Argument: 0}, []byte{0x40, 0x00, 0x00, 0x00, 0x00, 0x95}},
+		{sdmmc.Command{Index: 8, Argument: 0x1AA}, []byte{0x48, 0x00, 0x00, 0x01, 0xAA, 0x87}},
+		{sdmmc.Command{Index: 17, Argument: 0}, []byte{0x51, 0x00, 0x00, 0x00, 0x00, 0x55}},
+		{sdmmc.Command{Index: 55, Argument: 0}, []byte{0x77, 0x00, 0x00, 0x00, 0x00, 0x65}},
+		{sdmmc.Command{Index: 41, Argument: 0x40000000}, []byte{0x69, 0x40, 0x00, 0x00, 0x00, 0x77}},
+	}
+	for _, tt := range tests {
+		got, err := sdmmc.AppendCommand(nil, tt.cmd)
+		if err != nil || !bytes.Equal(got, tt.token) {
+			t.Errorf("AppendCommand(%+v) = % X, %v, want % X", tt.cmd, got, err, tt.token)
+		}
+		decoded, err := sdmmc.DecodeCommand(tt.token)
+		if err != nil || decoded != tt.cmd {
+			t.Errorf("DecodeCommand(% X) = %+v, %v, want %+v", tt.token, decoded, err, tt.cmd)
+		}
+		if _, err = sdmmc.DecodeResponse(tt.token); err != sdmmc.ErrFormat {
+			t.Errorf("DecodeResponse(% X) error = %v, want %v", tt.token, err, sdmmc.ErrFormat)
+		}
+
+		for i := 2; i < 8*sdmmc.TokenSize-1; i++ {
+			corrupted := append([]byte{}, tt.token...)
+			corrupted[i/8] ^= 0x80 >> uint(i%8)
+			if _, err = sdmmc.DecodeCommand(corrupted); err != sdmmc.ErrCRC {
+				t.Errorf("DecodeCommand(% X) error = %v, want %v", corrupted, err, sdmmc.ErrCRC)
+			}
+		}
+	}
+
+	// R7 response to CMD8
+	response := sdmmc.Command{Index: 8, Argument: 0x1AA}
+	token, _ := sdmmc.AppendResponse(nil, response)
+	if got, err := sdmmc.DecodeResponse(token); err != nil || got != response {
+		t.Errorf("DecodeResponse(% X) = %+v, %v, want %+v", token, got, err, response)
+	}
+
+	if _, err := sdmmc.AppendCommand(nil, sdmmc.Command{Index: 64}); err == nil {
+		t.Errorf("AppendCommand(64) returned no error")
+	}
+	for _, token := range [][]byte{
+		{0x40, 0x00, 0x00, 0x00, 0x00},
+		{0x40, 0x00, 0x00, 0x00, 0x00, 0x94},
+		{0xC0, 0x00, 0x00, 0x00, 0x00, 0x95},
+	} {
+		if _, err := sdmmc.DecodeCommand(token); err != sdmmc.ErrFormat {
+			t.Errorf("DecodeCommand(% X) error = %v, want %v", token, err, sdmmc.ErrFormat)
+		}
+	}
+}
+
+// dataCRC4Bitwise calculates CRC-16 of DAT lines bit by bit as done by the card
+func dataCRC4Bitwise(data []byte) [4]uint16 {
+	var crcs [4]uint16
+	for _, b := range data {
+		for _, nibble := range []byte{b >> 4, b & 0xF} {
+			for i := range crcs {
+				in := uint16(nibble>>uint(i)&1) ^ crcs[i]>>15
+				crcs[i] <<= 1
+				if in != 0 {
+					crcs[i] ^= 0x1021
+				}
+			}
+		}
+	}
+	return crcs
+}
+
+func TestDataCRC(t *testing.T) {
+	ones := bytes.Repeat([]byte{0xFF}, 512)
+	if got := sdmmc.DataCRC1(ones); got != 0x7FA1 {
+		t.Errorf("DataCRC1(512 bytes of 0xFF) = 0x%04X, want 0x7FA1", got)
+	}
+	if got := sdmmc.DataCRC4(bytes.Repeat(ones, 4)); got != [4]uint16{0x7FA1, 0x7FA1, 0x7FA1, 0x7FA1} {
+		t.Errorf("DataCRC4(2048 bytes of 0xFF) = %04X, want 0x7FA1 on every line", got)
+	}
+
+	data := make([]byte, 1030)
+	for i := range data {
+		data[i] = byte(i*i + 7*i)
+	}
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 255, 256, 257, 512, 1030} {
+		if got, want := sdmmc.DataCRC4(data[:n]), dataCRC4Bitwise(data[:n]); got != want {
+			t.Errorf("DataCRC4(%d bytes) = %04X, want %04X", n, got, want)
+		}
+
+		block := sdmmc.AppendDataCRC4(append([]byte{}, data[:n]...), data[:n])
+		if len(block) != n+sdmmc.DataCRC4Size {
+			t.Fatalf("AppendDataCRC4() length = %d, want %d", len(block), n+sdmmc.DataCRC4Size)
+		}
+		if !sdmmc.CheckDataCRC4(block) {
+			t.Errorf("CheckDataCRC4(%d bytes) = false", n)
+		}
+		block[len(block)-1-n/2] ^= 0x04
+		if sdmmc.CheckDataCRC4(block) {
+			t.Errorf("CheckDataCRC4(%d bytes corrupted) = true", n)
+		}
+	}
+	if sdmmc.CheckDataCRC4(make([]byte, sdmmc.DataCRC4Size-1)) {
+		t.Errorf("CheckDataCRC4() of short block = true")
+	}
+}
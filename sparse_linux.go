@@ -0,0 +1,65 @@
+package crc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"syscall"
+)
+
+// whence values of lseek(2) not defined by package syscall
+const (
+	seekData = 3
+	seekHole = 4
+)
+
+// sumSparse calculates CRC of the file reading only its data regions found by SEEK_DATA and SEEK_HOLE.
+// Files which have all their blocks allocated are left to other methods.
+func sumSparse(ctx context.Context, f *os.File, fi os.FileInfo, table *Table, c *sumConfig) (uint64, error) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	size := fi.Size()
+	if !ok || !fi.Mode().IsRegular() || st.Blocks*512 >= size {
+		return 0, errSparseNotSupported
+	}
+
+	crc := table.InitCrc()
+	var pos int64
+	for pos < size {
+		data, err := f.Seek(pos, seekData)
+		if errors.Is(err, syscall.ENXIO) {
+			// no more data, the rest of the file is a hole
+			data = size
+		} else if err != nil {
+			if pos == 0 {
+				return 0, errSparseNotSupported
+			}
+			return 0, err
+		}
+		if data > size {
+			data = size
+		}
+		hole := size
+		if data < size {
+			if hole, err = f.Seek(data, seekHole); err != nil {
+				return 0, err
+			}
+			if hole > size {
+				hole = size
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		crc = table.UpdateZeros(crc, data-pos)
+		if c.progress != nil && data > pos {
+			c.progress(data)
+		}
+		if crc, _, err = updateReader(ctx, io.NewSectionReader(f, data, hole-data), table, c, crc, data); err != nil {
+			return 0, err
+		}
+		pos = hole
+	}
+	return table.CRC(crc), nil
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package crc
+
+import (
+	"context"
+	"os"
+)
+
+// sumSparse is not supported on this platform, holes are read as zeros
+func sumSparse(ctx context.Context, f *os.File, fi os.FileInfo, table *Table, c *sumConfig) (uint64, error) {
+	return 0, errSparseNotSupported
+}
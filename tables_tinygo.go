@@ -0,0 +1,7 @@
+//go:build tinygo
+
+package crc
+
+// staticTables is empty in TinyGo builds to keep binaries small,
+// lookup tables are always computed by NewTable.
+var staticTables = map[tableKey]*[256]uint64{}
@@ -0,0 +1,167 @@
+package crc
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Unsigned is a constraint that permits any unsigned integer type
+// suitable for storing a CRC value.
+type Unsigned interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// bitSize returns number of bits in type T
+func bitSize[T Unsigned]() uint {
+	return uint(bits.Len64(uint64(^T(0))))
+}
+
+// TypedTable is a generic counterpart of Table which stores the lookup table
+// and returns CRC values using type T instead of uint64. Like Table, it is
+// essentially immutable once initialized and thread safe as a result.
+type TypedTable[T Unsigned] struct {
+	crcParams Parameters
+	crctable  []T
+	mask      T
+	initValue T
+}
+
+// NewTypedTable creates and initializes a new TypedTable for the CRC algorithm specified by the crcParams.
+// It returns an error if the CRC width does not fit into type T.
+func NewTypedTable[T Unsigned](crcParams *Parameters) (*TypedTable[T], error) {
+	size := bitSize[T]()
+	if crcParams.Width == 0 || crcParams.Width > size {
+		return nil, fmt.Errorf("width %d does not fit into %d bit type", crcParams.Width, size)
+	}
+
+	table := NewTable(crcParams)
+	ret := &TypedTable[T]{crcParams: *crcParams}
+	ret.mask = T(table.mask)
+	ret.initValue = T(table.initValue)
+	ret.crctable = make([]T, 256)
+	for i, v := range table.crctable {
+		ret.crctable[i] = T(v)
+	}
+	return ret, nil
+}
+
+// InitCrc returns a stating value for a new CRC calculation
+func (t *TypedTable[T]) InitCrc() T {
+	return t.initValue
+}
+
+// UpdateCrc process supplied bytes and updates current (partial) CRC accordingly.
+// It can be called repetitively to process larger data in chunks.
+func (t *TypedTable[T]) UpdateCrc(curValue T, p []byte) T {
+	if t.crcParams.ReflectIn {
+		for _, v := range p {
+			curValue = t.crctable[byte(curValue)^v] ^ T(uint64(curValue)>>8)
+		}
+	} else if t.crcParams.Width < 8 {
+		for _, v := range p {
+			curValue = t.crctable[byte(curValue<<(8-t.crcParams.Width))^v] ^ T(uint64(curValue)<<8)
+		}
+	} else {
+		for _, v := range p {
+			curValue = t.crctable[byte(curValue>>(t.crcParams.Width-8))^v] ^ T(uint64(curValue)<<8)
+		}
+	}
+	return curValue
+}
+
+// CRC returns CRC value for the data processed so far.
+func (t *TypedTable[T]) CRC(curValue T) T {
+	ret := curValue
+
+	if t.crcParams.ReflectOut != t.crcParams.ReflectIn {
+		ret = T(reflect(uint64(ret), t.crcParams.Width))
+	}
+	return (ret ^ T(t.crcParams.FinalXor)) & t.mask
+}
+
+// CalculateCRC is a convenience function allowing to calculate CRC in one call.
+func (t *TypedTable[T]) CalculateCRC(data []byte) T {
+	crc := t.InitCrc()
+	crc = t.UpdateCrc(crc, data)
+	return t.CRC(crc)
+}
+
+// TypedHash is a generic counterpart of Hash backed by a TypedTable.
+// It also implements hash.Hash interface.
+type TypedHash[T Unsigned] struct {
+	table    *TypedTable[T]
+	curValue T
+	size     uint
+}
+
+// NewTypedHashWithTable creates a new TypedHash instance configured for table driven
+// CRC calculation using a TypedTable instance created elsewhere.
+func NewTypedHashWithTable[T Unsigned](table *TypedTable[T]) *TypedHash[T] {
+	ret := &TypedHash[T]{table: table}
+	ret.size = (table.crcParams.Width + 7) / 8 // smalest number of bytes enough to store produced crc
+	ret.Reset()
+	return ret
+}
+
+// NewTypedHash creates a new TypedHash instance configured for table driven
+// CRC calculation according to parameters specified.
+// It returns an error if the CRC width does not fit into type T.
+func NewTypedHash[T Unsigned](crcParams *Parameters) (*TypedHash[T], error) {
+	table, err := NewTypedTable[T](crcParams)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypedHashWithTable(table), nil
+}
+
+// Size returns the number of bytes Sum will return.
+// See hash.Hash interface.
+func (h *TypedHash[T]) Size() int { return int(h.size) }
+
+// BlockSize returns the hash's underlying block size.
+// See hash.Hash interface.
+func (h *TypedHash[T]) BlockSize() int { return 1 }
+
+// Reset resets the TypedHash to its initial state.
+// See hash.Hash interface.
+func (h *TypedHash[T]) Reset() {
+	h.curValue = h.table.InitCrc()
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+// See hash.Hash interface.
+func (h *TypedHash[T]) Sum(in []byte) []byte {
+	s := uint64(h.CRC())
+	for i := h.size; i > 0; {
+		i--
+		in = append(in, byte(s>>(8*i)))
+	}
+	return in
+}
+
+// Write implements io.Writer interface which is part of hash.Hash interface.
+func (h *TypedHash[T]) Write(p []byte) (n int, err error) {
+	h.Update(p)
+	return len(p), nil
+}
+
+// Update updates process supplied bytes and updates current (partial) CRC accordingly.
+func (h *TypedHash[T]) Update(p []byte) {
+	h.curValue = h.table.UpdateCrc(h.curValue, p)
+}
+
+// CRC returns current CRC value for the data processed so far.
+func (h *TypedHash[T]) CRC() T {
+	return h.table.CRC(h.curValue)
+}
+
+// CalculateCRC is a convenience function allowing to calculate CRC in one call.
+func (h *TypedHash[T]) CalculateCRC(data []byte) T {
+	return h.table.CalculateCRC(data)
+}
+
+// Table used by this TypedHash under the hood
+func (h *TypedHash[T]) Table() *TypedTable[T] {
+	return h.table
+}
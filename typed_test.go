@@ -0,0 +1,73 @@
+package crc_test
+
+import (
+	"testing"
+
+	"github.com/ast-dd/crc"
+)
+
+func testTypedTable[T crc.Unsigned](t *testing.T, crcParams *crc.Parameters) {
+	t.Helper()
+	for _, testString := range byteTestStrings {
+		data := []byte(testString)
+		want := crc.CalculateCRC(crcParams, data)
+
+		table, err := crc.NewTypedTable[T](crcParams)
+		if err != nil {
+			t.Fatalf("NewTypedTable() error = %v", err)
+		}
+		if got := table.CalculateCRC(data); uint64(got) != want {
+			t.Errorf("TypedTable.CalculateCRC(%q) = 0x%x, want 0x%x", testString, got, want)
+		}
+
+		h := crc.NewTypedHashWithTable(table)
+		h.Update(data[:len(data)/2])
+		h.Update(data[len(data)/2:])
+		if got := h.CRC(); uint64(got) != want {
+			t.Errorf("TypedHash.CRC(%q) = 0x%x, want 0x%x", testString, got, want)
+		}
+
+		sum := crc.NewHash(crcParams).Sum(nil)
+		if got := h.Sum(nil); len(got) != len(sum) {
+			t.Errorf("TypedHash.Sum() returned %d bytes, want %d", len(got), len(sum))
+		}
+	}
+}
+
+func TestTypedTable(t *testing.T) {
+	testTypedTable[uint8](t, crc.CRC8SAEJ1850)
+	testTypedTable[uint8](t, crc.CRC8DARC)
+	testTypedTable[uint8](t, &crc.Parameters{Width: 3, Polynomial: 0x03, Init: 0x00, FinalXor: 0x7})
+	testTypedTable[uint8](t, &crc.Parameters{Width: 7, Polynomial: 0x4f, Init: 0x7f, ReflectIn: true, ReflectOut: true})
+	testTypedTable[uint16](t, crc.CRC16MODBUS)
+	testTypedTable[uint16](t, crc.CCITT)
+	testTypedTable[uint16](t, &crc.Parameters{Width: 12, Polynomial: 0x80f, ReflectOut: true})
+	testTypedTable[uint32](t, crc.CRC32)
+	testTypedTable[uint32](t, crc.CRC32BZIP2)
+	testTypedTable[uint32](t, &crc.Parameters{Width: 24, Polynomial: 0x864cfb, Init: 0xb704ce})
+	testTypedTable[uint64](t, crc.CRC64ECMA)
+	testTypedTable[uint64](t, crc.CRC16X25)
+}
+
+func TestTypedTableWidth(t *testing.T) {
+	if _, err := crc.NewTypedTable[uint8](crc.CRC16MODBUS); err == nil {
+		t.Errorf("NewTypedTable[uint8]() accepted width 16")
+	}
+	if _, err := crc.NewTypedHash[uint16](crc.CRC32); err == nil {
+		t.Errorf("NewTypedHash[uint16]() accepted width 32")
+	}
+	if _, err := crc.NewTypedHash[uint32](crc.CRC64ISO); err == nil {
+		t.Errorf("NewTypedHash[uint32]() accepted width 64")
+	}
+	if _, err := crc.NewTypedHash[uint64](crc.CRC64ISO); err != nil {
+		t.Errorf("NewTypedHash[uint64]() error = %v", err)
+	}
+}
+
+func BenchmarkTypedCCITT(b *testing.B) {
+	data := []byte(byteTestStrings[3])
+	table, _ := crc.NewTypedTable[uint16](crc.CCITT)
+	for i := 0; i < b.N; i++ {
+		table.CalculateCRC(data)
+	}
+}
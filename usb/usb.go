@@ -0,0 +1,208 @@
+// Package usb implements packet identifiers and CRC protection of USB token and data packets.
+//
+// Packets start with a PID byte carrying the 4 bit packet type and its complement as check nibble.
+// Token packets follow it with 11 bits of address and endpoint, or frame number for SOF,
+// protected by CRC-5/USB. Data packets follow it with the payload protected by CRC-16/USB.
+// Fields are transmitted least significant bit first, so both CRCs are stored in little endian order.
+package usb
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/ast-dd/crc"
+)
+
+const (
+	// Residue5 is the CRC-5 shift register after receiving token fields with valid CRC,
+	// with the first received bit as the most significant one.
+	Residue5 = 0x0C
+	// Residue16 is the CRC-16 shift register after receiving data with valid CRC,
+	// with the first received bit as the most significant one.
+	Residue16 = 0x800D
+
+	// TokenSize is the size of token packets including PID.
+	TokenSize = 3
+	// MinDataSize is the size of data packets with empty payload, PID and CRC.
+	MinDataSize = 3
+)
+
+var (
+	table5  = crc.NewTable(crc.CRC5USB)
+	table16 = crc.NewTable(crc.CRC16USB)
+)
+
+// PID is a 4 bit packet identifier.
+type PID byte
+
+// Packet identifiers
+const (
+	PIDOut   PID = 0x1
+	PIDIn    PID = 0x9
+	PIDSOF   PID = 0x5
+	PIDSetup PID = 0xD
+
+	PIDData0 PID = 0x3
+	PIDData1 PID = 0xB
+	PIDData2 PID = 0x7
+	PIDMData PID = 0xF
+
+	PIDAck   PID = 0x2
+	PIDNak   PID = 0xA
+	PIDStall PID = 0xE
+	PIDNyet  PID = 0x6
+
+	PIDPre   PID = 0xC // PIDPre shares value with PIDErr, PRE is sent by hosts, ERR by hubs
+	PIDErr   PID = 0xC
+	PIDSplit PID = 0x8
+	PIDPing  PID = 0x4
+)
+
+var pidNames = [16]string{"Reserved", "OUT", "ACK", "DATA0", "PING", "SOF", "NYET", "DATA2", "SPLIT", "IN", "NAK", "DATA1", "PRE/ERR", "SETUP", "STALL", "MDATA"}
+
+// String returns the name of the PID.
+func (p PID) String() string {
+	if p > 0xF {
+		return fmt.Sprintf("PID(0x%X)", byte(p))
+	}
+	return pidNames[p]
+}
+
+// Byte returns the PID field as transmitted, PID in the low nibble and its complement in the high nibble.
+func (p PID) Byte() byte {
+	return byte(p&0xF) | ^byte(p)<<4
+}
+
+// IsToken reports whether p is PID of a token packet with CRC-5: OUT, IN, SOF, SETUP or PING.
+func (p PID) IsToken() bool {
+	return p <= 0xF && p&3 == 1 || p == PIDPing
+}
+
+// IsData reports whether p is PID of a data packet: DATA0, DATA1, DATA2 or MDATA.
+func (p PID) IsData() bool {
+	return p <= 0xF && p&3 == 3
+}
+
+var (
+	// ErrPID is returned for PID fields with invalid check nibble or unexpected packet type.
+	ErrPID = errors.New("usb: invalid PID")
+	// ErrCRC is returned for packets with CRC mismatch.
+	ErrCRC = errors.New("usb: CRC error")
+	// ErrLength is returned for packets of invalid length.
+	ErrLength = errors.New("usb: invalid packet length")
+)
+
+// ParsePID parses the PID field, verifying the check nibble.
+func ParsePID(b byte) (PID, error) {
+	p := PID(b & 0xF)
+	if p.Byte() != b {
+		return 0, ErrPID
+	}
+	return p, nil
+}
+
+// CRC5 calculates CRC-5 of the lowest 11 bits of v, as transmitted in token packets.
+func CRC5(v uint16) uint8 {
+	return uint8(table5.CRC(table5.UpdateBits(table5.InitCrc(), uint64(v), 11)))
+}
+
+// CRC16 calculates CRC-16 of data packet payload.
+func CRC16(data []byte) uint16 {
+	return uint16(table16.CalculateCRC(data))
+}
+
+// Token is a token packet. SOF tokens carry Frame, the others Address and Endpoint.
+type Token struct {
+	PID      PID
+	Address  uint8  // Address of the function, 7 bits
+	Endpoint uint8  // Endpoint number, 4 bits
+	Frame    uint16 // Frame number of SOF, 11 bits
+}
+
+// fields returns 11 bits following the PID
+func (t Token) fields() (uint16, error) {
+	if !t.PID.IsToken() {
+		return 0, ErrPID
+	}
+	if t.PID == PIDSOF {
+		if t.Frame > 0x7FF {
+			return 0, fmt.Errorf("usb: invalid frame number %d", t.Frame)
+		}
+		return t.Frame, nil
+	}
+	if t.Address > 0x7F || t.Endpoint > 0xF {
+		return 0, fmt.Errorf("usb: invalid address %d or endpoint %d", t.Address, t.Endpoint)
+	}
+	return uint16(t.Address) | uint16(t.Endpoint)<<7, nil
+}
+
+// AppendToken appends the token packet to dst and returns the extended slice.
+func AppendToken(dst []byte, t Token) ([]byte, error) {
+	v, err := t.fields()
+	if err != nil {
+		return dst, err
+	}
+	v |= uint16(CRC5(v)) << 11
+	return append(dst, t.PID.Byte(), byte(v), byte(v>>8)), nil
+}
+
+// DecodeToken parses a token packet including PID and verifies its CRC.
+func DecodeToken(packet []byte) (Token, error) {
+	if len(packet) != TokenSize {
+		return Token{}, ErrLength
+	}
+	pid, err := ParsePID(packet[0])
+	if err != nil {
+		return Token{}, err
+	}
+	if !pid.IsToken() {
+		return Token{}, ErrPID
+	}
+	v := uint64(packet[1]) | uint64(packet[2])<<8
+	if table5.UpdateBits(table5.InitCrc(), v, 16) != uint64(bits.Reverse8(Residue5)>>3) {
+		return Token{}, ErrCRC
+	}
+
+	t := Token{PID: pid}
+	if pid == PIDSOF {
+		t.Frame = uint16(v & 0x7FF)
+	} else {
+		t.Address, t.Endpoint = uint8(v&0x7F), uint8(v>>7&0xF)
+	}
+	return t, nil
+}
+
+// AppendData appends the data packet with given PID and payload to dst and returns the extended slice.
+func AppendData(dst []byte, pid PID, data []byte) ([]byte, error) {
+	if !pid.IsData() {
+		return dst, ErrPID
+	}
+	dst = append(append(dst, pid.Byte()), data...)
+	return table16.AppendCRC(dst, data), nil
+}
+
+// DecodeData parses a data packet including PID and verifies its CRC by the residue.
+// The returned payload refers to packet.
+func DecodeData(packet []byte) (PID, []byte, error) {
+	if len(packet) < MinDataSize {
+		return 0, nil, ErrLength
+	}
+	pid, err := ParsePID(packet[0])
+	if err != nil {
+		return 0, nil, err
+	}
+	if !pid.IsData() {
+		return 0, nil, ErrPID
+	}
+	if !CheckDataCRC(packet[1:]) {
+		return 0, nil, ErrCRC
+	}
+	return pid, packet[1 : len(packet)-2], nil
+}
+
+// CheckDataCRC reports whether data, payload followed by its CRC-16, is valid,
+// checking that the CRC register ends up with Residue16.
+func CheckDataCRC(data []byte) bool {
+	return table16.UpdateCrc(table16.InitCrc(), data) == uint64(bits.Reverse16(Residue16))
+}
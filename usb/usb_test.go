@@ -0,0 +1,140 @@
+package usb_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ast-dd/crc/usb"
+)
+
+func TestPID(t *testing.T) {
+	tests := []struct {
+		pid   usb.PID
+		b     byte
+		token bool
+		data  bool
+	}{
+		{usb.PIDOut, 0xE1, true, false},
+		{usb.PIDIn, 0x69, true, false},
+		{usb.PIDSOF, 0xA5, true, false},
+		{usb.PIDSetup, 0x2D, true, false},
+		{usb.PIDPing, 0xB4, true, false},
+		{usb.PIDData0, 0xC3, false, true},
+		{usb.PIDData1, 0x4B, false, true},
+		{usb.PIDData2, 0x87, false, true},
+		{usb.PIDMData, 0x0F, false, true},
+		{usb.PIDAck, 0xD2, false, false},
+		{usb.PIDNak, 0x5A, false, false},
+		{usb.PIDStall, 0x1E, false, false},
+		{usb.PIDNyet, 0x96, false, false},
+		{usb.PIDSplit, 0x78, false, false},
+	}
+	for _, tt := range tests {
+		if got := tt.pid.Byte(); got != tt.b {
+			t.Errorf("%v.Byte() = 0x%02X, want 0x%02X", tt.pid, got, tt.b)
+		}
+		if got, err := usb.ParsePID(tt.b); got != tt.pid || err != nil {
+			t.Errorf("ParsePID(0x%02X) = %v, %v, want %v", tt.b, got, err, tt.pid)
+		}
+		if tt.pid.IsToken() != tt.token || tt.pid.IsData() != tt.data {
+			t.Errorf("%v IsToken() = %v, IsData() = %v", tt.pid, tt.pid.IsToken(), tt.pid.IsData())
+		}
+	}
+	for _, p := range []usb.PID{0x11, 0x13, 0xF5} {
+		if p.IsToken() || p.IsData() {
+			t.Errorf("PID(0x%X) IsToken() = %v, IsData() = %v, want false", byte(p), p.IsToken(), p.IsData())
+		}
+		if _, err := usb.AppendToken(nil, usb.Token{PID: p}); err != usb.ErrPID {
+			t.Errorf("AppendToken(PID 0x%X) error = %v, want %v", byte(p), err, usb.ErrPID)
+		}
+	}
+	if _, err := usb.ParsePID(0x2C); err != usb.ErrPID {
+		t.Errorf("ParsePID(0x2C) error = %v, want %v", err, usb.ErrPID)
+	}
+}
+
+func TestToken(t *testing.T) {
+	// examples of the USB CRC white paper, CRC bits there are listed in transmission order
+	tests := []struct {
+		token  usb.Token
+		packet []byte
+	}{
+		{usb.Token{PID: usb.PIDSetup, Address: 0x00, Endpoint: 0x0}, []byte{0x2D, 0x00, 0x10}},
+		{usb.Token{PID: usb.PIDOut, Address: 0x15, Endpoint: 0xE}, []byte{0xE1, 0x15, 0xEF}},
+		{usb.Token{PID: usb.PIDIn, Address: 0x3A, Endpoint: 0xA}, []byte{0x69, 0x3A, 0x3D}},
+		{usb.Token{PID: usb.PIDOut, Address: 0x70, Endpoint: 0x4}, []byte{0xE1, 0x70, 0x72}},
+		{usb.Token{PID: usb.PIDSOF, Frame: 0x710}, []byte{0xA5, 0x10, 0x2F}},
+	}
+	for _, tt := range tests {
+		got, err := usb.AppendToken(nil, tt.token)
+		if err != nil || !bytes.Equal(got, tt.packet) {
+			t.Errorf("AppendToken(%+v) = % X, %v, want % X", tt.token, got, err, tt.packet)
+		}
+		decoded, err := usb.DecodeToken(tt.packet)
+		if err != nil || decoded != tt.token {
+			t.Errorf("DecodeToken(% X) = %+v, %v, want %+v", tt.packet, decoded, err, tt.token)
+		}
+		for i := 8; i < 8*usb.TokenSize; i++ {
+			corrupted := append([]byte{}, tt.packet...)
+			corrupted[i/8] ^= 1 << uint(i%8)
+			if _, err = usb.DecodeToken(corrupted); err == nil {
+				t.Errorf("DecodeToken(% X) returned no error", corrupted)
+			}
+		}
+	}
+
+	for _, token := range []usb.Token{
+		{PID: usb.PIDData0},
+		{PID: usb.PIDIn, Address: 0x80},
+		{PID: usb.PIDIn, Endpoint: 0x10},
+		{PID: usb.PIDSOF, Frame: 0x800},
+	} {
+		if _, err := usb.AppendToken(nil, token); err == nil {
+			t.Errorf("AppendToken(%+v) returned no error", token)
+		}
+	}
+	if _, err := usb.DecodeToken([]byte{0x2D, 0x00}); err != usb.ErrLength {
+		t.Errorf("DecodeToken() of short packet error = %v, want %v", err, usb.ErrLength)
+	}
+	if _, err := usb.DecodeToken([]byte{0xC3, 0x00, 0x10}); err != usb.ErrPID {
+		t.Errorf("DecodeToken() of data PID error = %v, want %v", err, usb.ErrPID)
+	}
+}
+
+func TestData(t *testing.T) {
+	// GET_DESCRIPTOR request in SETUP stage
+	setup := []byte{0x80, 0x06, 0x00, 0x01, 0x00, 0x00, 0x40, 0x00}
+	want := []byte{0xC3, 0x80, 0x06, 0x00, 0x01, 0x00, 0x00, 0x40, 0x00, 0xDD, 0x94}
+	got, err := usb.AppendData(nil, usb.PIDData0, setup)
+	if err != nil || !bytes.Equal(got, want) {
+		t.Errorf("AppendData() = % X, %v, want % X", got, err, want)
+	}
+
+	for _, payload := range [][]byte{nil, {0x00}, setup, bytes.Repeat([]byte{0xFF, 0x5A}, 512)} {
+		packet, _ := usb.AppendData(nil, usb.PIDData1, payload)
+		pid, data, err := usb.DecodeData(packet)
+		if err != nil || pid != usb.PIDData1 || !bytes.Equal(data, payload) {
+			t.Errorf("DecodeData(% X) = %v, % X, %v", packet, pid, data, err)
+		}
+		if crc := usb.CRC16(payload); packet[len(packet)-2] != byte(crc) || packet[len(packet)-1] != byte(crc>>8) {
+			t.Errorf("AppendData() CRC = % X, want 0x%04X", packet[len(packet)-2:], crc)
+		}
+		for i := 8; i < 8*len(packet) && i < 200; i++ {
+			corrupted := append([]byte{}, packet...)
+			corrupted[i/8] ^= 1 << uint(i%8)
+			if _, _, err = usb.DecodeData(corrupted); err != usb.ErrCRC {
+				t.Fatalf("DecodeData(% X) error = %v, want %v", corrupted, err, usb.ErrCRC)
+			}
+		}
+	}
+
+	if _, err = usb.AppendData(nil, usb.PIDAck, nil); err != usb.ErrPID {
+		t.Errorf("AppendData(ACK) error = %v, want %v", err, usb.ErrPID)
+	}
+	if _, _, err = usb.DecodeData([]byte{0xC3, 0x00}); err != usb.ErrLength {
+		t.Errorf("DecodeData() of short packet error = %v, want %v", err, usb.ErrLength)
+	}
+	if _, _, err = usb.DecodeData([]byte{0x2D, 0x00, 0x00}); err != usb.ErrPID {
+		t.Errorf("DecodeData() of token error = %v, want %v", err, usb.ErrPID)
+	}
+}
@@ -0,0 +1,60 @@
+package crc
+
+import (
+	"io"
+)
+
+// AppendingWriter forwards all data to underlying writer and appends
+// the CRC of that data as a trailer when closed.
+type AppendingWriter struct {
+	w          io.Writer
+	hash       *Hash
+	trailerLen int
+	order      ByteOrder
+	err        error
+	closed     bool
+}
+
+// NewAppendingWriter creates a new AppendingWriter writing to w. On Close, the CRC is written
+// as trailerLen bytes serialized using given byte order.
+// Closing AppendingWriter does not close the underlying writer.
+func NewAppendingWriter(w io.Writer, crcParams *Parameters, trailerLen int, order ByteOrder) *AppendingWriter {
+	return &AppendingWriter{
+		w:          w,
+		hash:       NewHash(crcParams),
+		trailerLen: trailerLen,
+		order:      order,
+		err:        checkTrailerLen(crcParams, trailerLen),
+	}
+}
+
+// Write implements io.Writer interface.
+func (a *AppendingWriter) Write(p []byte) (n int, err error) {
+	if a.err != nil {
+		return 0, a.err
+	}
+	if a.closed {
+		return 0, io.ErrClosedPipe
+	}
+	n, a.err = a.w.Write(p)
+	a.hash.Update(p[:n])
+	return n, a.err
+}
+
+// Close writes the CRC trailer. It implements io.Closer interface.
+func (a *AppendingWriter) Close() error {
+	if a.closed || a.err != nil {
+		return a.err
+	}
+	a.closed = true
+
+	trailer := make([]byte, a.trailerLen)
+	putChecksum(trailer, a.hash.CRC(), a.order)
+	_, a.err = a.w.Write(trailer)
+	return a.err
+}
+
+// Hash returns the Hash holding the CRC of the data written so far.
+func (a *AppendingWriter) Hash() *Hash {
+	return a.hash
+}
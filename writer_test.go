@@ -0,0 +1,51 @@
+package crc_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ast-dd/crc"
+)
+
+func TestAppendingWriter(t *testing.T) {
+	for _, tt := range byteTests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, testString := range byteTestStrings {
+				var buf bytes.Buffer
+				w := crc.NewAppendingWriter(&buf, tt.crcParams, len(tt.wantBytes[i]), crc.LittleEndian)
+				io.WriteString(w, testString[:len(testString)/2])
+				io.WriteString(w, testString[len(testString)/2:])
+				if err := w.Close(); err != nil {
+					t.Fatalf("Close() error = %v", err)
+				}
+				if got, want := buf.Bytes(), crc.AppendCRCBytes(tt.crcParams, []byte(testString)); !bytes.Equal(got, want) {
+					t.Errorf("AppendingWriter(%q) = %v, want %v", testString, got, want)
+				}
+
+				// round trip through VerifyingReader using big endian trailer
+				buf.Reset()
+				w = crc.NewAppendingWriter(&buf, tt.crcParams, 8, crc.BigEndian)
+				io.WriteString(w, testString)
+				w.Close()
+				got, err := io.ReadAll(crc.NewVerifyingReader(&buf, tt.crcParams, 8, crc.BigEndian))
+				if err != nil || string(got) != testString {
+					t.Errorf("VerifyingReader(AppendingWriter(%q)) = %q, %v", testString, got, err)
+				}
+			}
+		})
+	}
+}
+
+func TestTeeReader(t *testing.T) {
+	for _, testString := range byteTestStrings {
+		r := crc.NewTeeReader(bytes.NewReader([]byte(testString)), crc.CRC32)
+		got, err := io.ReadAll(r)
+		if err != nil || string(got) != testString {
+			t.Errorf("ReadAll(%q) = %q, %v", testString, got, err)
+		}
+		if got, want := r.Hash().CRC(), crc.CalculateCRC(crc.CRC32, []byte(testString)); got != want {
+			t.Errorf("TeeReader(%q).Hash().CRC() = 0x%X, want 0x%X", testString, got, want)
+		}
+	}
+}
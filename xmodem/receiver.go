@@ -0,0 +1,194 @@
+package xmodem
+
+import "io"
+
+// Receiver receives files from a Sender.
+type Receiver struct {
+	c        *conn
+	mode     Mode
+	fallback bool // fall back to checksum mode if CRC requests are not answered
+	buf      []byte
+}
+
+// NewReceiver creates a new Receiver communicating over rw.
+func NewReceiver(rw io.ReadWriter, opts Options) *Receiver {
+	return &Receiver{c: newConn(rw, opts), buf: make([]byte, BlockSize1K+4)}
+}
+
+// Receive receives a file sent using XMODEM, writes it to w and returns the number of bytes written.
+// As XMODEM does not transfer file size, the padding of the last block is written as well.
+func (r *Receiver) Receive(w io.Writer) (int64, error) {
+	r.mode = r.c.opts.Mode
+	r.fallback = r.mode == CRC
+	return r.receiveData(w, -1, false)
+}
+
+// Mode returns the mode used by the last transfer.
+func (r *Receiver) Mode() Mode {
+	return r.mode
+}
+
+// ReceiveFiles receives files sent using YMODEM batch protocol. For each file,
+// it calls create to get the writer for its data.
+func (r *Receiver) ReceiveFiles(create func(FileInfo) (io.Writer, error)) error {
+	r.mode = CRC
+	r.fallback = false
+	for {
+		header, num, data, err := r.readPacket(CRCRequest, true)
+		if err != nil {
+			return err
+		}
+		if header == EOT {
+			// retransmitted end of previous file
+			if err = r.c.writeByte(ACK); err != nil {
+				return err
+			}
+			continue
+		}
+		if num != 0 {
+			r.c.cancel()
+			return ErrSequence
+		}
+		info, err := parseHeader(data)
+		if err != nil {
+			r.c.cancel()
+			return err
+		}
+		if err = r.c.writeByte(ACK); err != nil || info.Name == "" {
+			return err
+		}
+
+		w, err := create(info)
+		if err != nil {
+			r.c.cancel()
+			return err
+		}
+		if _, err = r.receiveData(w, info.Size, true); err != nil {
+			return err
+		}
+	}
+}
+
+// receiveData receives blocks starting with number 1 until EOT, writing at most size bytes unless size is negative.
+// In YMODEM batch mode, the first EOT is answered by NAK to make sure the transfer really ended.
+func (r *Receiver) receiveData(w io.Writer, size int64, batch bool) (int64, error) {
+	req := byte(CRCRequest)
+	if r.mode == Checksum {
+		req = NAK
+	}
+	start, eot := true, false
+	expected := byte(1)
+	var total int64
+	for {
+		header, num, data, err := r.readPacket(req, start)
+		if err != nil {
+			return total, err
+		}
+		if header == EOT {
+			if batch && !eot {
+				eot, req = true, NAK
+				continue
+			}
+			return total, r.c.writeByte(ACK)
+		}
+		start = false
+		req = ACK
+		if num == expected-1 {
+			// our ACK got lost, the block was sent again
+			continue
+		}
+		if num != expected {
+			r.c.cancel()
+			return total, ErrSequence
+		}
+
+		if size >= 0 && int64(len(data)) > size-total {
+			data = data[:size-total]
+		}
+		if _, err = w.Write(data); err != nil {
+			r.c.cancel()
+			return total, err
+		}
+		total += int64(len(data))
+		expected++
+	}
+}
+
+// readPacket sends req and reads the next block or EOT, requesting retransmission until a valid one is received.
+// If the transfer has not started yet, timeouts repeat the start request, otherwise they send NAK.
+func (r *Receiver) readPacket(req byte, start bool) (header, num byte, data []byte, err error) {
+	for retry := 0; retry < r.c.opts.Retries; retry++ {
+		if start && r.fallback && r.mode == CRC && retry >= crcAttempts {
+			// switch only when NAK is sent, a block may still answer the last CRC request
+			r.mode, req = Checksum, NAK
+		}
+		if err = r.c.writeByte(req); err != nil && err != errTimeout {
+			return
+		}
+		if start {
+			req = CRCRequest
+			if r.mode == Checksum {
+				req = NAK
+			}
+		} else {
+			req = NAK
+		}
+
+		header, err = r.c.readByte()
+		if err == errTimeout {
+			continue
+		}
+		if err != nil {
+			return
+		}
+		switch header {
+		case EOT:
+			return
+		case CAN:
+			if r.c.canceled() {
+				err = ErrCanceled
+				return
+			}
+			continue
+		case SOH, STX:
+		default:
+			r.c.purge()
+			continue
+		}
+
+		size := BlockSize
+		if header == STX {
+			size = BlockSize1K
+		}
+		packet := r.buf[:2+size+r.checkSize()]
+		if err = r.c.readFull(packet); err == errTimeout {
+			continue
+		}
+		if err != nil {
+			return
+		}
+		if packet[0] != ^packet[1] || !r.valid(packet[2:]) {
+			r.c.purge()
+			continue
+		}
+		return header, packet[0], packet[2 : 2+size], nil
+	}
+	r.c.cancel()
+	return 0, 0, nil, ErrTooManyRetries
+}
+
+func (r *Receiver) checkSize() int {
+	if r.mode == Checksum {
+		return 1
+	}
+	return 2
+}
+
+// valid checks data followed by checksum or CRC
+func (r *Receiver) valid(p []byte) bool {
+	data, check := p[:len(p)-r.checkSize()], p[len(p)-r.checkSize():]
+	if r.mode == Checksum {
+		return checksum(data) == check[0]
+	}
+	return table.CRC16(table.UpdateCrc(table.InitCrc(), data)) == uint16(check[0])<<8|uint16(check[1])
+}
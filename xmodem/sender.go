@@ -0,0 +1,169 @@
+package xmodem
+
+import "io"
+
+// Sender sends files to a Receiver.
+type Sender struct {
+	c    *conn
+	mode Mode
+	buf  []byte
+}
+
+// NewSender creates a new Sender communicating over rw.
+func NewSender(rw io.ReadWriter, opts Options) *Sender {
+	return &Sender{c: newConn(rw, opts)}
+}
+
+// Send transfers data read from r until io.EOF using XMODEM.
+// The last block is padded by SUB bytes, which the receiver cannot tell apart from data.
+func (s *Sender) Send(r io.Reader) error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	return s.sendData(r)
+}
+
+// File is a file sent in a YMODEM batch.
+type File struct {
+	FileInfo
+	Data io.Reader // Data of the file, at most Size bytes are sent unless Size is negative
+}
+
+// SendFiles transfers files using YMODEM batch protocol.
+func (s *Sender) SendFiles(files ...File) error {
+	for _, f := range files {
+		header, err := appendHeader(nil, f.FileInfo)
+		if err != nil {
+			s.c.cancel()
+			return err
+		}
+		if err = s.start(); err != nil {
+			return err
+		}
+		if err = s.sendBlock(0, header); err != nil {
+			return err
+		}
+		if err = s.start(); err != nil {
+			return err
+		}
+		data := f.Data
+		if f.Size >= 0 {
+			data = io.LimitReader(data, f.Size)
+		}
+		if err = s.sendData(data); err != nil {
+			return err
+		}
+	}
+
+	// empty header ends the batch
+	if err := s.start(); err != nil {
+		return err
+	}
+	return s.sendBlock(0, make([]byte, BlockSize))
+}
+
+// start waits for the receiver to request the transfer, selecting the mode
+func (s *Sender) start() error {
+	for retry := 0; retry < s.c.opts.Retries; retry++ {
+		b, err := s.c.readByte()
+		if err == errTimeout {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		switch b {
+		case CRCRequest:
+			s.mode = CRC
+			return nil
+		case NAK:
+			s.mode = Checksum
+			return nil
+		case CAN:
+			if s.c.canceled() {
+				return ErrCanceled
+			}
+		}
+	}
+	s.c.cancel()
+	return ErrTooManyRetries
+}
+
+// sendData sends blocks starting with number 1 followed by EOT
+func (s *Sender) sendData(r io.Reader) error {
+	size := BlockSize
+	if s.c.opts.Block1K && s.mode == CRC {
+		size = BlockSize1K
+	}
+	data := make([]byte, size)
+	for num := byte(1); ; num++ {
+		n, err := io.ReadFull(r, data)
+		if n > 0 {
+			block := data[:n]
+			// short last block fits into 128 byte block
+			for len(block) > BlockSize && n < size {
+				if err := s.sendBlock(num, block[:BlockSize]); err != nil {
+					return err
+				}
+				block = block[BlockSize:]
+				num++
+			}
+			if err := s.sendBlock(num, block); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			s.c.cancel()
+			return err
+		}
+	}
+	return s.send([]byte{EOT})
+}
+
+func (s *Sender) sendBlock(num byte, data []byte) error {
+	s.buf = AppendBlock(s.buf[:0], num, data, s.mode)
+	return s.send(s.buf)
+}
+
+// send writes packet until it is acknowledged. Unexpected responses, such as
+// repeated requests to start the transfer, are ignored.
+func (s *Sender) send(packet []byte) error {
+	for retry := 0; retry < s.c.opts.Retries; retry++ {
+		if err := s.c.write(packet); err == errTimeout {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := s.waitAck(); err != errRetry {
+			return err
+		}
+	}
+	s.c.cancel()
+	return ErrTooManyRetries
+}
+
+// waitAck returns nil for ACK, ErrCanceled for CAN CAN and errRetry for NAK or timeout
+func (s *Sender) waitAck() error {
+	for {
+		b, err := s.c.readByte()
+		if err == errTimeout {
+			return errRetry
+		}
+		if err != nil {
+			return err
+		}
+		switch b {
+		case ACK:
+			return nil
+		case NAK:
+			return errRetry
+		case CAN:
+			if s.c.canceled() {
+				return ErrCanceled
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Package xmodem implements XMODEM and YMODEM batch file transfers.
+//
+// Data is transferred in blocks of 128 or 1024 bytes, each protected either by an arithmetic
+// checksum or by CRC-16/XMODEM. The receiver selects the mode by sending 'C' (CRC) or NAK (checksum)
+// and acknowledges every block by ACK, requests retransmission by NAK or aborts by CAN.
+// YMODEM batch transfers precede each file by block 0 carrying its name and size.
+//
+// Sender and Receiver work over any io.ReadWriter. If it also has SetReadDeadline and
+// SetWriteDeadline methods, as net.Conn does, timeouts are detected and recovered from by retransmission.
+package xmodem
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ast-dd/crc"
+)
+
+// Control characters of the protocol.
+const (
+	SOH = 0x01 // SOH starts a block of 128 bytes
+	STX = 0x02 // STX starts a block of 1024 bytes
+	EOT = 0x04 // EOT ends the transfer of a file
+	ACK = 0x06 // ACK acknowledges a block
+	NAK = 0x15 // NAK requests retransmission and starts checksum mode transfer
+	CAN = 0x18 // CAN cancels the transfer when sent twice
+	SUB = 0x1A // SUB pads the last block
+	// CRCRequest starts CRC mode transfer.
+	CRCRequest = 'C'
+)
+
+// Block sizes.
+const (
+	BlockSize   = 128
+	BlockSize1K = 1024
+)
+
+// Mode selects how blocks are protected.
+type Mode int
+
+const (
+	// CRC mode protects blocks with CRC-16/XMODEM.
+	CRC Mode = iota
+	// Checksum mode protects blocks with the sum of data bytes modulo 256.
+	Checksum
+)
+
+// String returns the name of the mode
+func (m Mode) String() string {
+	if m == Checksum {
+		return "Checksum"
+	}
+	return "CRC"
+}
+
+var table = crc.NewTable(crc.CRC16XMODEM)
+
+var (
+	// ErrCanceled is returned when the other side cancels the transfer.
+	ErrCanceled = errors.New("xmodem: transfer canceled by remote")
+	// ErrTooManyRetries is returned when a block could not be transferred within the allowed number of retries.
+	ErrTooManyRetries = errors.New("xmodem: too many retries")
+	// ErrSequence is returned by Receiver when it receives an unexpected block number.
+	ErrSequence = errors.New("xmodem: block out of sequence")
+	// ErrInvalidHeader is returned by Receiver for malformed YMODEM header blocks.
+	ErrInvalidHeader = errors.New("xmodem: invalid YMODEM header")
+
+	errTimeout = errors.New("xmodem: timeout")
+	errRetry   = errors.New("xmodem: retry")
+)
+
+const (
+	defaultRetries = 10
+	defaultTimeout = 10 * time.Second
+	// crcAttempts is the number of CRC requests sent before Receiver falls back to checksum mode
+	crcAttempts = 3
+)
+
+// Options configure Sender and Receiver. Zero values are replaced by defaults.
+type Options struct {
+	Mode    Mode          // Mode requested by Receiver, which falls back to checksum mode if the sender does not respond
+	Block1K bool          // Block1K makes Sender use 1024 byte blocks in CRC mode
+	Retries int           // Retries is the number of attempts to transfer a block, default 10
+	Timeout time.Duration // Timeout for responses, default 10 seconds
+}
+
+// AppendBlock appends a block with given number and data, padded by SUB to the block size, to dst.
+// Blocks of up to 128 bytes use SOH, larger ones up to 1024 bytes use STX.
+func AppendBlock(dst []byte, num byte, data []byte, mode Mode) []byte {
+	if len(data) > BlockSize1K {
+		panic("xmodem: block data too long")
+	}
+	header, size := byte(SOH), BlockSize
+	if len(data) > BlockSize {
+		header, size = STX, BlockSize1K
+	}
+	dst = append(dst, header, num, ^num)
+	start := len(dst)
+	dst = append(dst, data...)
+	for i := len(data); i < size; i++ {
+		dst = append(dst, SUB)
+	}
+	if mode == Checksum {
+		return append(dst, checksum(dst[start:]))
+	}
+	sum := table.CRC16(table.UpdateCrc(table.InitCrc(), dst[start:]))
+	return append(dst, byte(sum>>8), byte(sum))
+}
+
+func checksum(data []byte) byte {
+	var sum byte
+	for _, b := range data {
+		sum += b
+	}
+	return sum
+}
+
+// deadliner is implemented by net.Conn
+type deadliner interface {
+	SetReadDeadline(t time.Time) error
+	SetWriteDeadline(t time.Time) error
+}
+
+// conn reads and writes control characters and blocks with timeouts
+type conn struct {
+	w        io.Writer
+	r        *bufio.Reader
+	deadline deadliner
+	opts     Options
+}
+
+func newConn(rw io.ReadWriter, opts Options) *conn {
+	if opts.Retries <= 0 {
+		opts.Retries = defaultRetries
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultTimeout
+	}
+	c := &conn{w: rw, r: bufio.NewReaderSize(rw, BlockSize1K+8), opts: opts}
+	c.deadline, _ = rw.(deadliner)
+	return c
+}
+
+func (c *conn) readFull(p []byte) error {
+	if c.deadline != nil && c.r.Buffered() < len(p) {
+		if err := c.deadline.SetReadDeadline(time.Now().Add(c.opts.Timeout)); err != nil {
+			return err
+		}
+	}
+	_, err := io.ReadFull(c.r, p)
+	if errors.Is(err, os.ErrDeadlineExceeded) {
+		return errTimeout
+	}
+	return err
+}
+
+func (c *conn) readByte() (byte, error) {
+	var b [1]byte
+	err := c.readFull(b[:])
+	return b[0], err
+}
+
+func (c *conn) write(p []byte) error {
+	if c.deadline != nil {
+		if err := c.deadline.SetWriteDeadline(time.Now().Add(c.opts.Timeout)); err != nil {
+			return err
+		}
+	}
+	_, err := c.w.Write(p)
+	if errors.Is(err, os.ErrDeadlineExceeded) {
+		return errTimeout
+	}
+	return err
+}
+
+func (c *conn) writeByte(b byte) error {
+	return c.write([]byte{b})
+}
+
+// canceled reads the byte following CAN and reports whether the transfer was canceled
+func (c *conn) canceled() bool {
+	b, err := c.readByte()
+	return err == nil && b == CAN
+}
+
+// cancel aborts the transfer
+func (c *conn) cancel() {
+	c.write([]byte{CAN, CAN})
+}
+
+// purge discards incoming data until the line is silent
+func (c *conn) purge() {
+	if c.deadline == nil {
+		c.r.Discard(c.r.Buffered())
+		return
+	}
+	var buf [BlockSize1K]byte
+	for {
+		c.deadline.SetReadDeadline(time.Now().Add(c.opts.Timeout / 10))
+		if _, err := c.r.Read(buf[:]); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package xmodem_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ast-dd/crc"
+	"github.com/ast-dd/crc/xmodem"
+)
+
+func testData(n int) []byte {
+	data := make([]byte, n)
+	rand.New(rand.NewSource(int64(n))).Read(data)
+	return data
+}
+
+func padded(data []byte) []byte {
+	ret := append([]byte{}, data...)
+	for len(ret)%xmodem.BlockSize != 0 {
+		ret = append(ret, xmodem.SUB)
+	}
+	return ret
+}
+
+func TestAppendBlock(t *testing.T) {
+	block := xmodem.AppendBlock(nil, 1, []byte("123456789"), xmodem.CRC)
+	if len(block) != 3+xmodem.BlockSize+2 || block[0] != xmodem.SOH || block[1] != 1 || block[2] != 0xFE {
+		t.Fatalf("AppendBlock() = % X", block)
+	}
+	if block[3+9] != xmodem.SUB || crc.CalculateCRC(crc.CRC16XMODEM, block[3:]) != 0 {
+		t.Errorf("AppendBlock() has invalid data or CRC: % X", block)
+	}
+
+	block = xmodem.AppendBlock(nil, 0xFF, []byte{1, 2}, xmodem.Checksum)
+	sum := 3 + 126*xmodem.SUB
+	if len(block) != 3+xmodem.BlockSize+1 || block[1] != 0xFF || block[2] != 0 || block[len(block)-1] != byte(sum) {
+		t.Errorf("AppendBlock(checksum) = % X", block)
+	}
+
+	block = xmodem.AppendBlock(nil, 2, make([]byte, 129), xmodem.CRC)
+	if len(block) != 3+xmodem.BlockSize1K+2 || block[0] != xmodem.STX {
+		t.Errorf("AppendBlock(129 bytes) has header 0x%X and length %d", block[0], len(block))
+	}
+}
+
+// transfer runs sender and receiver connected by rw pairs and returns their errors
+func transfer(send func(*xmodem.Sender) error, receive func(*xmodem.Receiver) error, opts xmodem.Options, senderConn, receiverConn io.ReadWriter) (sendErr, receiveErr error) {
+	done := make(chan error)
+	go func() { done <- send(xmodem.NewSender(senderConn, opts)) }()
+	receiveErr = receive(xmodem.NewReceiver(receiverConn, opts))
+	return <-done, receiveErr
+}
+
+func TestTransfer(t *testing.T) {
+	for _, opts := range []xmodem.Options{
+		{Timeout: time.Second},
+		{Timeout: time.Second, Block1K: true},
+		{Timeout: time.Second, Block1K: true, Mode: xmodem.Checksum},
+	} {
+		for _, size := range []int{0, 1, 128, 1000, 1024, 5000} {
+			data := testData(size)
+			var got bytes.Buffer
+			var mode xmodem.Mode
+			senderConn, receiverConn := net.Pipe()
+			sendErr, receiveErr := transfer(
+				func(s *xmodem.Sender) error { return s.Send(bytes.NewReader(data)) },
+				func(r *xmodem.Receiver) error {
+					_, err := r.Receive(&got)
+					mode = r.Mode()
+					return err
+				}, opts, senderConn, receiverConn)
+			if sendErr != nil || receiveErr != nil {
+				t.Fatalf("%+v size %d: errors %v, %v", opts, size, sendErr, receiveErr)
+			}
+			if !bytes.Equal(got.Bytes(), padded(data)) {
+				t.Errorf("%+v size %d: received %d bytes", opts, size, got.Len())
+			}
+			if mode != opts.Mode {
+				t.Errorf("%+v size %d: mode %v", opts, size, mode)
+			}
+			senderConn.Close()
+			receiverConn.Close()
+		}
+	}
+}
+
+func TestChecksumFallback(t *testing.T) {
+	senderConn, receiverConn := net.Pipe()
+	defer senderConn.Close()
+	data := []byte("checksum only sender")
+
+	// sender ignoring CRC requests
+	go func() {
+		var b [1]byte
+		for b[0] != xmodem.NAK {
+			if _, err := senderConn.Read(b[:]); err != nil {
+				return
+			}
+		}
+		senderConn.Write(xmodem.AppendBlock(nil, 1, data, xmodem.Checksum))
+		senderConn.Read(b[:])
+		senderConn.Write([]byte{xmodem.EOT})
+		senderConn.Read(b[:])
+	}()
+
+	r := xmodem.NewReceiver(receiverConn, xmodem.Options{Timeout: 20 * time.Millisecond})
+	var got bytes.Buffer
+	if _, err := r.Receive(&got); err != nil {
+		t.Fatalf("Receive() error = %v", err)
+	}
+	if r.Mode() != xmodem.Checksum || !bytes.Equal(got.Bytes(), padded(data)) {
+		t.Errorf("Receive() got %q in mode %v", got.Bytes(), r.Mode())
+	}
+}
+
+func TestLateCRCResponse(t *testing.T) {
+	senderConn, receiverConn := net.Pipe()
+	defer senderConn.Close()
+	data := []byte("sender answering the last CRC request")
+
+	// sender answering the third CRC request
+	go func() {
+		var b [1]byte
+		for requests := 0; requests < 3; {
+			if _, err := senderConn.Read(b[:]); err != nil {
+				return
+			}
+			if b[0] == xmodem.CRCRequest {
+				requests++
+			}
+		}
+		senderConn.Write(xmodem.AppendBlock(nil, 1, data, xmodem.CRC))
+		senderConn.Read(b[:])
+		senderConn.Write([]byte{xmodem.EOT})
+		senderConn.Read(b[:])
+	}()
+
+	r := xmodem.NewReceiver(receiverConn, xmodem.Options{Timeout: 20 * time.Millisecond})
+	var got bytes.Buffer
+	if _, err := r.Receive(&got); err != nil {
+		t.Fatalf("Receive() error = %v", err)
+	}
+	if r.Mode() != xmodem.CRC || !bytes.Equal(got.Bytes(), padded(data)) {
+		t.Errorf("Receive() got %q in mode %v", got.Bytes(), r.Mode())
+	}
+}
+
+// corruptingProxy forwards data between two connections, flipping a bit of the byte at given
+// offset of stream from a to b and dropping the byte at given offset of stream from b to a
+func corruptingProxy(a, b net.Conn, corrupt, drop int) {
+	forward := func(dst, src net.Conn, f func(offset int, p []byte) []byte) {
+		buf := make([]byte, 2000)
+		offset := 0
+		for {
+			n, err := src.Read(buf)
+			if err != nil {
+				dst.Close()
+				return
+			}
+			p := f(offset, buf[:n])
+			offset += n
+			if _, err = dst.Write(p); err != nil {
+				return
+			}
+		}
+	}
+	go forward(b, a, func(offset int, p []byte) []byte {
+		if corrupt >= offset && corrupt < offset+len(p) {
+			p[corrupt-offset] ^= 0x10
+		}
+		return p
+	})
+	go forward(a, b, func(offset int, p []byte) []byte {
+		if drop >= offset && drop < offset+len(p) {
+			return append(p[:drop-offset:drop-offset], p[drop-offset+1:]...)
+		}
+		return p
+	})
+}
+
+func TestTransferErrors(t *testing.T) {
+	data := testData(3000)
+	opts := xmodem.Options{Timeout: 100 * time.Millisecond, Block1K: true}
+
+	for _, tt := range []struct{ corrupt, drop int }{
+		{corrupt: 1029 + 100},        // data of second block
+		{corrupt: 1029 + 1},          // block number
+		{corrupt: 1029},              // header byte
+		{corrupt: 3 * 1029, drop: 2}, // EOT, ACK of first block
+		{corrupt: -1, drop: 0},       // start request
+	} {
+		senderConn, a := net.Pipe()
+		b, receiverConn := net.Pipe()
+		corruptingProxy(a, b, tt.corrupt, tt.drop)
+
+		var got bytes.Buffer
+		sendErr, receiveErr := transfer(
+			func(s *xmodem.Sender) error { return s.Send(bytes.NewReader(data)) },
+			func(r *xmodem.Receiver) error { _, err := r.Receive(&got); return err },
+			opts, senderConn, receiverConn)
+		if sendErr != nil || receiveErr != nil {
+			t.Errorf("%+v: errors %v, %v", tt, sendErr, receiveErr)
+		} else if !bytes.Equal(got.Bytes(), padded(data)) {
+			t.Errorf("%+v: received %d bytes", tt, got.Len())
+		}
+		senderConn.Close()
+		receiverConn.Close()
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestCancel(t *testing.T) {
+	senderConn, receiverConn := net.Pipe()
+	defer senderConn.Close()
+	defer receiverConn.Close()
+	sendErr, receiveErr := transfer(
+		func(s *xmodem.Sender) error { return s.Send(bytes.NewReader(testData(1000))) },
+		func(r *xmodem.Receiver) error { _, err := r.Receive(failingWriter{}); return err },
+		xmodem.Options{Timeout: time.Second}, senderConn, receiverConn)
+	if sendErr != xmodem.ErrCanceled || receiveErr == nil {
+		t.Errorf("errors %v, %v", sendErr, receiveErr)
+	}
+}
+
+func TestTooManyRetries(t *testing.T) {
+	senderConn, receiverConn := net.Pipe()
+	defer senderConn.Close()
+	defer receiverConn.Close()
+	s := xmodem.NewSender(senderConn, xmodem.Options{Timeout: 10 * time.Millisecond, Retries: 3})
+	if err := s.Send(bytes.NewReader(nil)); err != xmodem.ErrTooManyRetries {
+		t.Errorf("Send() error = %v, want %v", err, xmodem.ErrTooManyRetries)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	modTime := time.Unix(1700000000, 0)
+	files := []xmodem.File{
+		{FileInfo: xmodem.FileInfo{Name: "firmware.bin", Size: 3000, ModTime: modTime, Mode: 0o100644}, Data: bytes.NewReader(testData(3000))},
+		{FileInfo: xmodem.FileInfo{Name: "empty", Size: 0}, Data: bytes.NewReader(nil)},
+		{FileInfo: xmodem.FileInfo{Name: "unknown size", Size: -1}, Data: bytes.NewReader(testData(200))},
+		{FileInfo: xmodem.FileInfo{Name: "short", Size: 10}, Data: bytes.NewReader(testData(128))},
+	}
+	wants := [][]byte{testData(3000), nil, padded(testData(200)), testData(128)[:10]}
+
+	senderConn, receiverConn := net.Pipe()
+	defer senderConn.Close()
+	defer receiverConn.Close()
+	var infos []xmodem.FileInfo
+	var gots []*bytes.Buffer
+	sendErr, receiveErr := transfer(
+		func(s *xmodem.Sender) error { return s.SendFiles(files...) },
+		func(r *xmodem.Receiver) error {
+			return r.ReceiveFiles(func(info xmodem.FileInfo) (io.Writer, error) {
+				infos = append(infos, info)
+				gots = append(gots, &bytes.Buffer{})
+				return gots[len(gots)-1], nil
+			})
+		}, xmodem.Options{Timeout: time.Second, Block1K: true}, senderConn, receiverConn)
+	if sendErr != nil || receiveErr != nil {
+		t.Fatalf("errors %v, %v", sendErr, receiveErr)
+	}
+	if len(infos) != len(files) {
+		t.Fatalf("received %d files, want %d", len(infos), len(files))
+	}
+	for i, f := range files {
+		if infos[i].Name != f.Name || infos[i].Size != f.Size || !infos[i].ModTime.Equal(f.ModTime) || infos[i].Mode != f.Mode {
+			t.Errorf("file %d info = %+v, want %+v", i, infos[i], f.FileInfo)
+		}
+		if !bytes.Equal(gots[i].Bytes(), wants[i]) {
+			t.Errorf("file %d has %d bytes, want %d", i, gots[i].Len(), len(wants[i]))
+		}
+	}
+}
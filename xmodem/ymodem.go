@@ -0,0 +1,103 @@
+package xmodem
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FileInfo describes a file in YMODEM header block.
+type FileInfo struct {
+	Name    string    // Name of the file, without directory
+	Size    int64     // Size of the file in bytes, -1 if unknown
+	ModTime time.Time // ModTime is the modification time, zero if unknown
+	Mode    uint32    // Mode holds Unix file mode bits, zero if unknown
+}
+
+// appendHeader appends data of YMODEM header block: file name, NUL, size,
+// modification time and mode, the latter two in octal, separated by spaces.
+// The fields following file name are optional, but positional, so they are all omitted for unknown size.
+// The header is padded by NUL bytes to the block size.
+func appendHeader(dst []byte, info FileInfo) ([]byte, error) {
+	dst, err := appendHeaderFields(dst, info)
+	if err != nil {
+		return dst, err
+	}
+	if len(dst) >= BlockSize1K {
+		return dst, fmt.Errorf("xmodem: file name %q too long", info.Name)
+	}
+	size := BlockSize
+	if len(dst) >= BlockSize {
+		size = BlockSize1K
+	}
+	return append(dst, make([]byte, size-len(dst))...), nil
+}
+
+func appendHeaderFields(dst []byte, info FileInfo) ([]byte, error) {
+	if info.Name == "" || strings.IndexByte(info.Name, 0) >= 0 {
+		return dst, fmt.Errorf("xmodem: invalid file name %q", info.Name)
+	}
+	dst = append(dst, info.Name...)
+	dst = append(dst, 0)
+	if info.Size < 0 {
+		return dst, nil
+	}
+	dst = strconv.AppendInt(dst, info.Size, 10)
+	if !info.ModTime.IsZero() || info.Mode != 0 {
+		var modTime int64
+		if !info.ModTime.IsZero() {
+			modTime = info.ModTime.Unix()
+		}
+		dst = append(dst, ' ')
+		dst = strconv.AppendInt(dst, modTime, 8)
+	}
+	if info.Mode != 0 {
+		dst = append(dst, ' ')
+		dst = strconv.AppendUint(dst, uint64(info.Mode), 8)
+	}
+	return dst, nil
+}
+
+// parseHeader is the reverse of appendHeader, empty name marks the end of a batch
+func parseHeader(data []byte) (FileInfo, error) {
+	info := FileInfo{Size: -1}
+	end := bytes.IndexByte(data, 0)
+	if end < 0 {
+		return info, ErrInvalidHeader
+	}
+	info.Name = string(data[:end])
+	if info.Name == "" {
+		return info, nil
+	}
+
+	rest := data[end+1:]
+	if end := bytes.IndexByte(rest, 0); end >= 0 {
+		rest = rest[:end]
+	}
+	fields := strings.Fields(string(rest))
+	var err error
+	if len(fields) > 0 {
+		if info.Size, err = strconv.ParseInt(fields[0], 10, 64); err != nil || info.Size < 0 {
+			return info, ErrInvalidHeader
+		}
+	}
+	if len(fields) > 1 {
+		modTime, err := strconv.ParseInt(fields[1], 8, 64)
+		if err != nil {
+			return info, ErrInvalidHeader
+		}
+		if modTime != 0 {
+			info.ModTime = time.Unix(modTime, 0)
+		}
+	}
+	if len(fields) > 2 {
+		mode, err := strconv.ParseUint(fields[2], 8, 32)
+		if err != nil {
+			return info, ErrInvalidHeader
+		}
+		info.Mode = uint32(mode)
+	}
+	return info, nil
+}
@@ -0,0 +1,56 @@
+package crc
+
+// gf2Matrix is a linear operator on CRC registers, column k is the image of register with only bit k set
+type gf2Matrix []uint64
+
+func (m gf2Matrix) apply(v uint64) uint64 {
+	var ret uint64
+	for k := 0; v != 0; k++ {
+		if v&1 != 0 {
+			ret ^= m[k]
+		}
+		v >>= 1
+	}
+	return ret
+}
+
+// square returns the operator m applied twice
+func (m gf2Matrix) square() gf2Matrix {
+	ret := make(gf2Matrix, len(m))
+	for k, column := range m {
+		ret[k] = m.apply(column)
+	}
+	return ret
+}
+
+// UpdateZeros works like UpdateCrc with n zero bytes, but takes O(log n) time.
+// Processing a zero byte is a linear operator, its powers of two are precomputed
+// by repeated squaring on first use.
+func (t *Table) UpdateZeros(curValue uint64, n int64) uint64 {
+	curValue &= t.mask
+	if n <= 0 || curValue == 0 {
+		return curValue
+	}
+
+	t.shiftsOnce.Do(t.initShifts)
+	for k := 0; n != 0; k++ {
+		if n&1 != 0 {
+			curValue = t.shifts[k].apply(curValue)
+		}
+		n >>= 1
+	}
+	return curValue
+}
+
+func (t *Table) initShifts() {
+	zero := [1]byte{0}
+	m := make(gf2Matrix, t.crcParams.Width)
+	for k := range m {
+		m[k] = t.UpdateCrc(uint64(1)<<uint(k), zero[:]) & t.mask
+	}
+	t.shifts = make([]gf2Matrix, 63)
+	for k := range t.shifts {
+		t.shifts[k] = m
+		m = m.square()
+	}
+}
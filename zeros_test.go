@@ -0,0 +1,50 @@
+package crc_test
+
+import (
+	"testing"
+
+	"github.com/ast-dd/crc"
+)
+
+func TestTableUpdateZeros(t *testing.T) {
+	params := []*crc.Parameters{
+		{Width: 3, Polynomial: 0x03, Init: 0x07, ReflectIn: true, ReflectOut: true},
+		{Width: 7, Polynomial: 0x09},
+		{Width: 12, Polynomial: 0x80f, ReflectOut: true},
+	}
+	for _, name := range crc.ParametersNames() {
+		p, _ := crc.GetParameters(name)
+		params = append(params, p)
+	}
+
+	zeros := make([]byte, 1000)
+	for _, p := range params {
+		table := crc.NewTable(p)
+		for _, n := range []int{0, 1, 2, 7, 64, 255, 1000} {
+			start := table.UpdateCrc(table.InitCrc(), []byte(byteTestStrings[2]))
+			want := table.CRC(table.UpdateCrc(start, zeros[:n]))
+			if got := table.CRC(table.UpdateZeros(start, int64(n))); got != want {
+				t.Errorf("%+v UpdateZeros(%d) = 0x%X, want 0x%X", *p, n, got, want)
+			}
+		}
+	}
+}
+
+func TestTableUpdateZerosLarge(t *testing.T) {
+	table := crc.NewTable(crc.CRC32)
+	zeros := make([]byte, 1<<20)
+	want := table.InitCrc()
+	for i := 0; i < 5; i++ {
+		want = table.UpdateCrc(want, zeros)
+	}
+	if got := table.UpdateZeros(table.InitCrc(), 5<<20); table.CRC(got) != table.CRC(want) {
+		t.Errorf("UpdateZeros(5 MiB) = 0x%X, want 0x%X", table.CRC(got), table.CRC(want))
+	}
+}
+
+func BenchmarkTableUpdateZeros(b *testing.B) {
+	table := crc.NewTable(crc.CRC64ECMA)
+	for i := 0; i < b.N; i++ {
+		table.UpdateZeros(table.InitCrc(), 1<<40)
+	}
+}